@@ -0,0 +1,74 @@
+// equivalence confere, contra um BFF rodando, que as rotas de agregação
+// devolvem o mesmo corpo para todos os slugs do catálogo. A primeira rota de
+// -modes é a referência; as outras precisam ser idênticas a ela, inclusive
+// na ordem de categorias e imagens.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"benchmark/internal/env"
+)
+
+func main() {
+	baseURL := flag.String("url", env.String("BASE_URL", "http://localhost:8080"), "URL base do BFF")
+	modes := flag.String("modes", "sequencial,paralelo", "rotas comparadas, separadas por vírgula; a primeira é a referência")
+	catalogSize := flag.Int("catalog-size", env.Int("CATALOG_SIZE", 100), "produtos no catálogo (mesmo CATALOG_SIZE do docker-compose)")
+	flag.Parse()
+
+	routes := strings.Split(*modes, ",")
+	if len(routes) < 2 || *catalogSize < 1 {
+		log.Fatal("informe ao menos duas rotas em -modes e um catalog-size positivo")
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	differences := 0
+	for id := 1; id <= *catalogSize; id++ {
+		slug := fmt.Sprintf("nome-do-produto-%d", id)
+		reference, err := fetch(client, *baseURL, routes[0], slug)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, route := range routes[1:] {
+			body, err := fetch(client, *baseURL, route, slug)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !bytes.Equal(body, reference) {
+				differences++
+				fmt.Printf("%s: /%s difere de /%s\n  %s: %s  %s: %s", slug, route, routes[0], routes[0], reference, route, body)
+			}
+		}
+	}
+
+	if differences > 0 {
+		fmt.Printf("%d diferenças em %d slugs\n", differences, *catalogSize)
+		os.Exit(1)
+	}
+	fmt.Printf("%s idênticos nos %d slugs\n", strings.Join(routes, ", "), *catalogSize)
+}
+
+// fetch devolve o corpo precedido do status, para que uma rota que falha não
+// pareça igual a outra que responde 200 com o mesmo corpo
+func fetch(client *http.Client, baseURL, route, slug string) ([]byte, error) {
+	resp, err := client.Get(fmt.Sprintf("%s/%s/%s", baseURL, route, slug))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(strconv.Itoa(resp.StatusCode)+" "), body...), nil
+}
@@ -0,0 +1,287 @@
+// loadgen reproduz o cenário do antigo stress-test.js sem depender do k6: VUs
+// concorrentes pedem /paralelo/{slug} (ou /sequencial/{slug}, /lote/{slug},
+// /servidor/{slug}, /multiplexado/{slug})
+// com slugs nome-do-produto-N aleatórios durante um tempo fixo e, no fim,
+// gravam o resumo no layout do resultado-*.summary.json.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"os"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"benchmark/internal/env"
+	"benchmark/summary"
+)
+
+// Mesmos thresholds do antigo stress-test.js
+var thresholds = map[string][]string{
+	"http_req_duration": {"avg<500", "p(90)<1000"},
+	"http_reqs":         {"rate>100"},
+	"http_req_failed":   {"rate<0.01"},
+}
+
+const checkName = "Status 200"
+
+type config struct {
+	baseURL     string
+	mode        string
+	vus         int
+	duration    time.Duration
+	catalogSize int
+	summaryPath string
+	exportPath  string
+}
+
+func main() {
+	cfg := config{}
+	flag.StringVar(&cfg.baseURL, "url", env.String("BASE_URL", "http://localhost:8080"), "URL base do BFF")
+	flag.StringVar(&cfg.mode, "mode", "paralelo", "rota do BFF: paralelo, sequencial, lote, servidor ou multiplexado (só gRPC)")
+	flag.IntVar(&cfg.vus, "vus", 50, "usuários virtuais simultâneos")
+	flag.DurationVar(&cfg.duration, "duration", time.Minute, "tempo total de execução")
+	flag.IntVar(&cfg.catalogSize, "catalog-size", env.Int("CATALOG_SIZE", 100), "produtos no catálogo (mesmo CATALOG_SIZE do docker-compose)")
+	flag.StringVar(&cfg.summaryPath, "summary", "", "arquivo do resumo no layout do resultado-*.summary.json")
+	flag.StringVar(&cfg.exportPath, "export", "", "arquivo do resumo no layout do resultado-*.consolidado.json")
+	flag.Parse()
+
+	switch cfg.mode {
+	case "paralelo", "sequencial", "lote", "servidor", "multiplexado":
+	default:
+		log.Fatalf("modo inválido %q: use paralelo, sequencial, lote, servidor ou multiplexado", cfg.mode)
+	}
+	if cfg.vus < 1 || cfg.catalogSize < 1 {
+		log.Fatal("vus e catalog-size devem ser positivos")
+	}
+
+	s := run(cfg)
+
+	if cfg.summaryPath != "" {
+		if err := s.WriteFile(cfg.summaryPath); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if cfg.exportPath != "" {
+		if err := s.Export().WriteFile(cfg.exportPath); err != nil {
+			log.Fatal(err)
+		}
+	}
+	printSummary(os.Stdout, s)
+
+	// Mesmo código de saída do k6 quando algum threshold falha
+	if !s.Passed() {
+		os.Exit(99)
+	}
+}
+
+func run(cfg config) *summary.Summary {
+	var sent, received atomic.Int64
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				conn, err := dialer.DialContext(ctx, network, addr)
+				if err != nil {
+					return nil, err
+				}
+				return &countingConn{Conn: conn, sent: &sent, received: &received}, nil
+			},
+			MaxIdleConnsPerHost: cfg.vus,
+		},
+		Timeout: 60 * time.Second,
+	}
+
+	log.Printf("%d VUs em %s/%s por %s", cfg.vus, cfg.baseURL, cfg.mode, cfg.duration)
+
+	start := time.Now()
+	deadline := start.Add(cfg.duration)
+	collectors := make([]*collector, cfg.vus)
+
+	var wg sync.WaitGroup
+	for i := range collectors {
+		c := &collector{}
+		collectors[i] = c
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for time.Now().Before(deadline) {
+				id := rand.IntN(cfg.catalogSize) + 1
+				url := fmt.Sprintf("%s/%s/nome-do-produto-%d", cfg.baseURL, cfg.mode, id)
+				s := request(client, url)
+				switch {
+				case s.err != nil:
+					log.Printf("Erro de rede para produto: %v", s.err)
+				case s.status != http.StatusOK:
+					log.Printf("Status %d para produto", s.status)
+				}
+				c.add(s)
+			}
+		}()
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	return buildSummary(merge(collectors), cfg.vus, elapsed, sent.Load(), received.Load())
+}
+
+// request faz uma iteração do cenário e mede cada fase com httptrace; se a
+// requisição não chega a uma resposta completa, a amostra sai com err e sem
+// os tempos das fases
+func request(client *http.Client, url string) (s sample) {
+	var getConn, connectStart, tlsStart, gotConn, wroteRequest, firstByte time.Time
+
+	trace := &httptrace.ClientTrace{
+		GetConn:      func(string) { getConn = time.Now() },
+		ConnectStart: func(string, string) { connectStart = time.Now() },
+		ConnectDone: func(string, string, error) {
+			s.connecting = time.Since(connectStart)
+		},
+		TLSHandshakeStart: func() { tlsStart = time.Now() },
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			s.tlsHandshaking = time.Since(tlsStart)
+		},
+		GotConn: func(httptrace.GotConnInfo) {
+			gotConn = time.Now()
+			s.blocked = gotConn.Sub(getConn) - s.connecting - s.tlsHandshaking
+		},
+		WroteRequest:         func(httptrace.WroteRequestInfo) { wroteRequest = time.Now() },
+		GotFirstResponseByte: func() { firstByte = time.Now() },
+	}
+
+	start := time.Now()
+	defer func() { s.iteration = time.Since(start) }()
+
+	req, err := http.NewRequestWithContext(httptrace.WithClientTrace(context.Background(), trace), "GET", url, nil)
+	if err != nil {
+		return sample{err: err}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return sample{err: err}
+	}
+	defer resp.Body.Close()
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		return sample{status: resp.StatusCode, err: err}
+	}
+	done := time.Now()
+
+	s.status = resp.StatusCode
+	s.sending = wroteRequest.Sub(gotConn)
+	s.waiting = firstByte.Sub(wroteRequest)
+	s.receiving = done.Sub(firstByte)
+	return s
+}
+
+func buildSummary(m *metrics, vus int, elapsed time.Duration, sent, received int64) *summary.Summary {
+	s := &summary.Summary{
+		Options: summary.Options{SummaryTrendStats: summary.TrendStats},
+		State:   summary.State{TestRunDurationMs: float64(elapsed) / float64(time.Millisecond)},
+		Metrics: map[string]summary.Metric{
+			"http_req_duration":                         trendMetric(m.duration),
+			"http_req_duration{expected_response:true}": trendMetric(m.durationExpected),
+			"http_req_blocked":                          trendMetric(m.blocked),
+			"http_req_connecting":                       trendMetric(m.connecting),
+			"http_req_tls_handshaking":                  trendMetric(m.tlsHandshaking),
+			"http_req_sending":                          trendMetric(m.sending),
+			"http_req_waiting":                          trendMetric(m.waiting),
+			"http_req_receiving":                        trendMetric(m.receiving),
+			"iteration_duration":                        trendMetric(m.iteration),
+			"http_reqs":                                 counterMetric(summary.ContainsDefault, float64(m.requests), elapsed),
+			"iterations":                                counterMetric(summary.ContainsDefault, float64(m.requests), elapsed),
+			"data_sent":                                 counterMetric(summary.ContainsData, float64(sent), elapsed),
+			"data_received":                             counterMetric(summary.ContainsData, float64(received), elapsed),
+			"http_req_errors":                           counterMetric(summary.ContainsDefault, float64(m.errors), elapsed),
+			"http_req_failed":                           rateMetric(m.failed, m.requests),
+			"checks":                                    rateMetric(m.checksPassed, m.requests),
+			"vus":                                       gaugeMetric(float64(vus)),
+			"vus_max":                                   gaugeMetric(float64(vus)),
+		},
+		RootGroup: summary.NewRootGroup(summary.Check{
+			Name:   checkName,
+			Passes: m.checksPassed,
+			Fails:  m.requests - m.checksPassed,
+		}),
+	}
+
+	for name, exprs := range thresholds {
+		metric := s.Metrics[name]
+		metric.Thresholds = make(map[string]summary.Threshold, len(exprs))
+		for _, expr := range exprs {
+			ok, err := summary.EvaluateThreshold(expr, metric.Values)
+			if err != nil {
+				log.Fatal(err)
+			}
+			metric.Thresholds[expr] = summary.Threshold{OK: ok}
+		}
+		s.Metrics[name] = metric
+	}
+	return s
+}
+
+func printSummary(w io.Writer, s *summary.Summary) {
+	names := make([]string, 0, len(s.Metrics))
+	for name := range s.Metrics {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		m := s.Metrics[name]
+		fmt.Fprintf(w, "%-45s", name)
+		switch m.Type {
+		case summary.TypeTrend:
+			for _, stat := range summary.TrendStats {
+				fmt.Fprintf(w, " %s=%.2fms", stat, m.Values[stat])
+			}
+		case summary.TypeCounter:
+			fmt.Fprintf(w, " %.0f %.2f/s", m.Values["count"], m.Values["rate"])
+		case summary.TypeRate:
+			fmt.Fprintf(w, " %.2f%% ✓ %.0f ✗ %.0f", m.Values["rate"]*100, m.Values["passes"], m.Values["fails"])
+		case summary.TypeGauge:
+			fmt.Fprintf(w, " %.0f", m.Values["value"])
+		}
+		fmt.Fprintln(w)
+
+		exprs := make([]string, 0, len(m.Thresholds))
+		for expr := range m.Thresholds {
+			exprs = append(exprs, expr)
+		}
+		slices.Sort(exprs)
+		for _, expr := range exprs {
+			mark := "✓"
+			if !m.Thresholds[expr].OK {
+				mark = "✗"
+			}
+			fmt.Fprintf(w, "  %s %s\n", mark, expr)
+		}
+	}
+}
+
+// countingConn soma os bytes trafegados, como data_sent e data_received
+type countingConn struct {
+	net.Conn
+	sent, received *atomic.Int64
+}
+
+func (c *countingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.received.Add(int64(n))
+	return n, err
+}
+
+func (c *countingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.sent.Add(int64(n))
+	return n, err
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "-0") {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"id":1}`))
+	}))
+	client := server.Client()
+
+	s := request(client, server.URL+"/paralelo/nome-do-produto-1")
+	if s.err != nil || s.status != http.StatusOK || s.failed() {
+		t.Fatalf("%+v, esperado 200", s)
+	}
+	if s.waiting <= 0 || s.iteration < s.duration() {
+		t.Errorf("tempos inconsistentes: %+v", s)
+	}
+
+	if s := request(client, server.URL+"/paralelo/nome-do-produto-0"); s.status != http.StatusNotFound || !s.failed() || s.err != nil {
+		t.Errorf("%+v, esperado 404 sem erro de rede", s)
+	}
+
+	server.Close()
+	s = request(client, server.URL+"/paralelo/nome-do-produto-1")
+	if s.err == nil || !s.failed() {
+		t.Fatalf("%+v, esperado erro de rede", s)
+	}
+	if s.duration() != 0 || s.iteration <= 0 {
+		t.Errorf("erro de rede com duração %s e iteração %s", s.duration(), s.iteration)
+	}
+}
+
+func TestBuildSummary(t *testing.T) {
+	m := merge([]*collector{{samples: []sample{
+		{status: 200, waiting: 10 * time.Millisecond},
+		{status: 200, waiting: 20 * time.Millisecond},
+		{err: http.ErrHandlerTimeout},
+	}}})
+	s := buildSummary(m, 2, time.Second, 100, 200)
+
+	if got := s.Metrics["http_req_errors"].Values["count"]; got != 1 {
+		t.Errorf("http_req_errors = %v, esperado 1", got)
+	}
+	if got := s.Metrics["http_req_duration"].Values["min"]; got != 10 {
+		t.Errorf("min = %v, esperado 10", got)
+	}
+	failed := s.Metrics["http_req_failed"]
+	if failed.Values["passes"] != 1 || failed.Thresholds["rate<0.01"].OK {
+		t.Errorf("http_req_failed %+v, esperado 1 falha reprovando o threshold", failed)
+	}
+}
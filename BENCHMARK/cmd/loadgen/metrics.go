@@ -0,0 +1,165 @@
+package main
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"benchmark/summary"
+)
+
+// trend guarda as amostras de uma métrica de tempo, em milissegundos
+type trend []float64
+
+func (t *trend) add(d time.Duration) {
+	*t = append(*t, float64(d)/float64(time.Millisecond))
+}
+
+// values calcula avg, min, max e p(90) como o k6: percentis por
+// interpolação linear entre as amostras ordenadas
+func (t trend) values() map[string]float64 {
+	values := map[string]float64{"avg": 0, "min": 0, "max": 0, "p(90)": 0}
+	if len(t) == 0 {
+		return values
+	}
+
+	sorted := slices.Clone(t)
+	slices.Sort(sorted)
+
+	var sum float64
+	for _, v := range sorted {
+		sum += v
+	}
+	values["avg"] = sum / float64(len(sorted))
+	values["min"] = sorted[0]
+	values["max"] = sorted[len(sorted)-1]
+	values["p(90)"] = percentile(sorted, 0.9)
+	return values
+}
+
+func percentile(sorted []float64, pct float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	i := pct * float64(len(sorted)-1)
+	lower := sorted[int(math.Floor(i))]
+	upper := sorted[int(math.Ceil(i))]
+	return lower + (upper-lower)*(i-math.Floor(i))
+}
+
+// sample são os tempos de uma requisição, nas mesmas fases do k6; err é o
+// erro de rede de uma requisição que não teve resposta completa
+type sample struct {
+	status         int
+	err            error
+	blocked        time.Duration
+	connecting     time.Duration
+	tlsHandshaking time.Duration
+	sending        time.Duration
+	waiting        time.Duration
+	receiving      time.Duration
+	iteration      time.Duration
+}
+
+func (s sample) duration() time.Duration {
+	return s.sending + s.waiting + s.receiving
+}
+
+// failed segue o http_req_failed do k6: status fora de 200-399 ou erro de rede
+func (s sample) failed() bool {
+	return s.err != nil || s.status < 200 || s.status > 399
+}
+
+// collector acumula as amostras de um VU; cada VU tem o seu, e eles são
+// somados só no fim para não disputar lock durante o teste
+type collector struct {
+	samples []sample
+}
+
+func (c *collector) add(s sample) {
+	c.samples = append(c.samples, s)
+}
+
+type metrics struct {
+	duration         trend
+	durationExpected trend
+	blocked          trend
+	connecting       trend
+	tlsHandshaking   trend
+	sending          trend
+	waiting          trend
+	receiving        trend
+	iteration        trend
+
+	requests     int
+	failed       int
+	errors       int
+	checksPassed int
+}
+
+func merge(collectors []*collector) *metrics {
+	m := &metrics{}
+	for _, c := range collectors {
+		for _, s := range c.samples {
+			m.requests++
+			m.iteration.add(s.iteration)
+			// sem resposta não há fases para medir: os tempos zerados
+			// puxariam avg e min para baixo justamente sob falhas
+			if s.err != nil {
+				m.failed++
+				m.errors++
+				continue
+			}
+
+			m.duration.add(s.duration())
+			m.blocked.add(s.blocked)
+			m.connecting.add(s.connecting)
+			m.tlsHandshaking.add(s.tlsHandshaking)
+			m.sending.add(s.sending)
+			m.waiting.add(s.waiting)
+			m.receiving.add(s.receiving)
+
+			if s.failed() {
+				m.failed++
+			} else {
+				m.durationExpected.add(s.duration())
+			}
+			if s.status == 200 {
+				m.checksPassed++
+			}
+		}
+	}
+	return m
+}
+
+func trendMetric(t trend) summary.Metric {
+	return summary.Metric{Type: summary.TypeTrend, Contains: summary.ContainsTime, Values: t.values()}
+}
+
+func counterMetric(contains string, count float64, elapsed time.Duration) summary.Metric {
+	return summary.Metric{
+		Type:     summary.TypeCounter,
+		Contains: contains,
+		Values:   map[string]float64{"count": count, "rate": count / elapsed.Seconds()},
+	}
+}
+
+func rateMetric(passes, total int) summary.Metric {
+	rate := 0.0
+	if total > 0 {
+		rate = float64(passes) / float64(total)
+	}
+	return summary.Metric{
+		Type:     summary.TypeRate,
+		Contains: summary.ContainsDefault,
+		Values:   map[string]float64{"rate": rate, "passes": float64(passes), "fails": float64(total - passes)},
+	}
+}
+
+func gaugeMetric(value float64) summary.Metric {
+	return summary.Metric{
+		Type:     summary.TypeGauge,
+		Contains: summary.ContainsDefault,
+		Values:   map[string]float64{"value": value, "min": value, "max": value},
+	}
+}
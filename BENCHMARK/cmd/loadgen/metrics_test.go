@@ -0,0 +1,42 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTrendValues(t *testing.T) {
+	var tr trend
+	for _, ms := range []int{5, 1, 4, 2, 3} {
+		tr.add(time.Duration(ms) * time.Millisecond)
+	}
+	want := map[string]float64{"avg": 3, "min": 1, "max": 5, "p(90)": 4.6}
+	for stat, v := range tr.values() {
+		if diff := v - want[stat]; diff > 1e-9 || diff < -1e-9 {
+			t.Errorf("%s = %v, esperado %v", stat, v, want[stat])
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	ok := sample{status: 200, sending: time.Millisecond, waiting: 8 * time.Millisecond, receiving: time.Millisecond, iteration: 11 * time.Millisecond}
+	notFound := sample{status: 404, waiting: 2 * time.Millisecond, iteration: 3 * time.Millisecond}
+	refused := sample{err: errors.New("connection refused"), iteration: 7 * time.Millisecond}
+
+	m := merge([]*collector{{samples: []sample{ok, refused}}, {samples: []sample{notFound}}})
+
+	if m.requests != 3 || m.failed != 2 || m.errors != 1 || m.checksPassed != 1 {
+		t.Errorf("requests=%d failed=%d errors=%d checks=%d, esperado 3 2 1 1", m.requests, m.failed, m.errors, m.checksPassed)
+	}
+	// o erro de rede não entra nos tempos das fases, só na iteração
+	if len(m.duration) != 2 || len(m.waiting) != 2 || len(m.blocked) != 2 {
+		t.Errorf("%d amostras de duração, esperado 2", len(m.duration))
+	}
+	if v := m.duration.values(); v["min"] != 2 || v["avg"] != 6 {
+		t.Errorf("duração %v, esperado min 2 e avg 6", v)
+	}
+	if len(m.durationExpected) != 1 || len(m.iteration) != 3 {
+		t.Errorf("%d esperadas e %d iterações, esperado 1 e 3", len(m.durationExpected), len(m.iteration))
+	}
+}
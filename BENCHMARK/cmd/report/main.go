@@ -0,0 +1,52 @@
+// report lê os resultados de todas as stacks (resultado-*.summary.json ou
+// .consolidado.json, .cpu.csv e .rxtx.csv), agrupa as execuções por
+// protocolo e modo e grava a comparação em Markdown, HTML e CSV.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	out := flag.String("out", "relatorio", "diretório onde o relatório é gravado")
+	flag.Parse()
+
+	dirs := flag.Args()
+	if len(dirs) == 0 {
+		var err error
+		dirs, err = filepath.Glob("../*/SCRIPTS")
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	runs, err := loadRuns(dirs)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(runs) == 0 {
+		log.Fatalf("Nenhum resultado encontrado em %v", dirs)
+	}
+
+	groups, err := aggregate(runs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatal(err)
+	}
+	for name, render := range map[string]func(string, []*group) error{
+		"report.md":   writeMarkdown,
+		"report.html": writeHTML,
+		"report.csv":  writeCSV,
+	} {
+		if err := render(filepath.Join(*out, name), groups); err != nil {
+			log.Fatal(err)
+		}
+	}
+	log.Printf("Relatório de %d execuções gravado em %s", len(runs), *out)
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type column struct {
+	Metric string
+	Title  string
+	format func(float64) string
+}
+
+var summaryColumns = []column{
+	{metricLatencyAvg, "Latência média (ms)", formatFloat},
+	{metricLatencyP90, "Latência p90 (ms)", formatFloat},
+	{metricThroughput, "Vazão (req/s)", formatFloat},
+	{metricErrorRate, "Taxa de erro", formatPercent},
+}
+
+var containerColumns = []column{
+	{metricCPU, "CPU (núcleos)", formatCores},
+	{metricRX, "Rede rx", formatBytes},
+	{metricTX, "Rede tx", formatBytes},
+}
+
+func formatFloat(v float64) string   { return strconv.FormatFloat(v, 'f', 2, 64) }
+func formatPercent(v float64) string { return strconv.FormatFloat(v*100, 'f', 2, 64) + "%" }
+func formatCores(v float64) string   { return strconv.FormatFloat(v, 'f', 3, 64) }
+
+func formatBytes(v float64) string {
+	for _, u := range []struct {
+		unit string
+		mult float64
+	}{{"GB/s", 1e9}, {"MB/s", 1e6}, {"kB/s", 1e3}} {
+		if v >= u.mult {
+			return strconv.FormatFloat(v/u.mult, 'f', 2, 64) + " " + u.unit
+		}
+	}
+	return strconv.FormatFloat(v, 'f', 0, 64) + " B/s"
+}
+
+// cell formata a média com a margem do intervalo de confiança de 95%
+func (c column) cell(s stats, ok bool) string {
+	if !ok || s.N == 0 {
+		return "-"
+	}
+	if s.N == 1 {
+		return c.format(s.Mean)
+	}
+	return c.format(s.Mean) + " ± " + c.format(s.margin())
+}
+
+// table é uma tabela já formatada, usada tanto no Markdown quanto no HTML
+type table struct {
+	Title  string
+	Header []string
+	Rows   [][]string
+}
+
+func tables(groups []*group) []table {
+	overview := table{Title: "Latência, vazão e erros", Header: []string{"Protocolo", "Modo", "Execuções"}}
+	for _, c := range summaryColumns {
+		overview.Header = append(overview.Header, c.Title)
+	}
+	for _, g := range groups {
+		row := []string{g.Protocol, g.Mode, strconv.Itoa(g.Runs)}
+		for _, c := range summaryColumns {
+			s, ok := g.Metrics[c.Metric]
+			row = append(row, c.cell(s, ok))
+		}
+		overview.Rows = append(overview.Rows, row)
+	}
+
+	result := []table{overview}
+	containers := roles(groups)
+	for _, c := range containerColumns {
+		t := table{Title: c.Title + " por container", Header: []string{"Protocolo", "Modo"}}
+		t.Header = append(t.Header, containers...)
+		for _, g := range groups {
+			row := []string{g.Protocol, g.Mode}
+			for _, role := range containers {
+				s, ok := g.Containers[role][c.Metric]
+				row = append(row, c.cell(s, ok))
+			}
+			t.Rows = append(t.Rows, row)
+		}
+		result = append(result, t)
+	}
+	return result
+}
+
+const reportNote = "Valores no formato média ± margem do intervalo de confiança de 95% (t de Student) entre as execuções. " +
+	"CPU e rede são a média das amostras do Grafana em cada execução."
+
+func writeMarkdown(path string, groups []*group) error {
+	var b strings.Builder
+	b.WriteString("# Comparação entre protocolos\n\n")
+	b.WriteString(reportNote + "\n")
+	for _, t := range tables(groups) {
+		fmt.Fprintf(&b, "\n## %s\n\n", t.Title)
+		b.WriteString("| " + strings.Join(t.Header, " | ") + " |\n")
+		b.WriteString("|" + strings.Repeat(" --- |", len(t.Header)) + "\n")
+		for _, row := range t.Rows {
+			b.WriteString("| " + strings.Join(row, " | ") + " |\n")
+		}
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
+
+var htmlReport = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="pt-BR">
+<head>
+<meta charset="utf-8">
+<title>Comparação entre protocolos</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 4px 10px; text-align: right; }
+th:first-child, td:first-child, th:nth-child(2), td:nth-child(2) { text-align: left; }
+th { background: #f0f0f0; }
+</style>
+</head>
+<body>
+<h1>Comparação entre protocolos</h1>
+<p>{{.Note}}</p>
+{{range .Tables}}
+<h2>{{.Title}}</h2>
+<table>
+<tr>{{range .Header}}<th>{{.}}</th>{{end}}</tr>
+{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}</table>
+{{end}}
+</body>
+</html>
+`))
+
+func writeHTML(path string, groups []*group) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return htmlReport.Execute(f, struct {
+		Note   string
+		Tables []table
+	}{reportNote, tables(groups)})
+}
+
+// writeCSV grava em formato longo, uma linha por métrica, para análise em
+// planilha ou pandas
+func writeCSV(path string, groups []*group) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	w.Write([]string{"protocol", "mode", "metric", "container", "n", "mean", "stddev", "ci95_low", "ci95_high"})
+	write := func(g *group, metric, container string, s stats) {
+		w.Write([]string{
+			g.Protocol, g.Mode, metric, container, strconv.Itoa(s.N),
+			strconv.FormatFloat(s.Mean, 'g', -1, 64),
+			strconv.FormatFloat(s.StdDev, 'g', -1, 64),
+			strconv.FormatFloat(s.CILow, 'g', -1, 64),
+			strconv.FormatFloat(s.CIHigh, 'g', -1, 64),
+		})
+	}
+	containers := roles(groups)
+	for _, g := range groups {
+		for _, c := range summaryColumns {
+			if s, ok := g.Metrics[c.Metric]; ok {
+				write(g, c.Metric, "", s)
+			}
+		}
+		for _, role := range containers {
+			for _, c := range containerColumns {
+				if s, ok := g.Containers[role][c.Metric]; ok {
+					write(g, c.Metric, role, s)
+				}
+			}
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
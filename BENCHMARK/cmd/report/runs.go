@@ -0,0 +1,231 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"benchmark/grafana"
+	"benchmark/summary"
+)
+
+const defaultMode = "paralelo"
+
+// resultado-{protocolo}[-{modo}]-{N}.{tipo}; sem modo a execução é do
+// /paralelo, como nos resultados gravados pelo k6
+var runFile = regexp.MustCompile(`^resultado-([a-z0-9]+)(?:-(paralelo|sequencial|lote|servidor|multiplexado))?-(\d+)\.(summary\.json|consolidado\.json|cpu\.csv|rxtx\.csv|rxtx-pod-\d+\.csv)$`)
+
+type runKey struct {
+	Protocol string
+	Mode     string
+	Number   int
+}
+
+// run é uma execução do teste de carga com os arquivos exportados dela
+type run struct {
+	runKey
+	Summary *summary.Summary
+	CPU     grafana.Panel
+	Network *grafana.Network
+}
+
+// loadRuns procura os arquivos de resultado nos diretórios e agrupa os que
+// pertencem à mesma execução
+func loadRuns(dirs []string) ([]*run, error) {
+	files := map[runKey]map[string]string{}
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			m := runFile.FindStringSubmatch(e.Name())
+			if m == nil {
+				continue
+			}
+			mode := m[2]
+			if mode == "" {
+				mode = defaultMode
+			}
+			number, _ := strconv.Atoi(m[3])
+			key := runKey{Protocol: m[1], Mode: mode, Number: number}
+			if files[key] == nil {
+				files[key] = map[string]string{}
+			}
+			kind := m[4]
+			if strings.HasPrefix(kind, "rxtx-pod-") {
+				kind = "rxtx-pod"
+			}
+			files[key][kind] = filepath.Join(dir, e.Name())
+		}
+	}
+
+	var runs []*run
+	for key, kinds := range files {
+		r := &run{runKey: key}
+		var err error
+		switch {
+		case kinds["summary.json"] != "":
+			r.Summary, err = summary.ReadFile(kinds["summary.json"])
+		case kinds["consolidado.json"] != "":
+			r.Summary, err = summary.ReadExportFile(kinds["consolidado.json"])
+		default:
+			log.Printf("Ignorando %s-%s-%d: sem summary.json nem consolidado.json", key.Protocol, key.Mode, key.Number)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if path := kinds["cpu.csv"]; path != "" {
+			if r.CPU, err = grafana.ReadCPU(path); err != nil {
+				return nil, err
+			}
+		}
+		if path := kinds["rxtx.csv"]; path != "" {
+			if r.Network, err = grafana.ReadNetwork(path); err != nil {
+				return nil, err
+			}
+		} else if kinds["rxtx-pod"] != "" {
+			// exportações antigas, um painel por pod e sem indicar se a série
+			// é de rx ou de tx
+			log.Printf("Ignorando a rede de %s-%s-%d: exporte o painel completo como resultado-*.rxtx.csv", key.Protocol, key.Mode, key.Number)
+		}
+		runs = append(runs, r)
+	}
+
+	// ordem fixa para o relatório não mudar entre uma geração e outra
+	slices.SortFunc(runs, func(a, b *run) int {
+		return cmp.Or(
+			strings.Compare(a.Protocol, b.Protocol),
+			strings.Compare(a.Mode, b.Mode),
+			cmp.Compare(a.Number, b.Number),
+		)
+	})
+	return runs, nil
+}
+
+const (
+	metricLatencyAvg = "latency_avg_ms"
+	metricLatencyP90 = "latency_p90_ms"
+	metricThroughput = "throughput_rps"
+	metricErrorRate  = "error_rate"
+	metricCPU        = "cpu_cores"
+	metricRX         = "rx_bytes_per_s"
+	metricTX         = "tx_bytes_per_s"
+)
+
+// group reúne as execuções de um protocolo em um modo
+type group struct {
+	Protocol string
+	Mode     string
+	Runs     int
+	Metrics  map[string]stats
+	// Containers é indexado pelo papel do container (bff, brands, ...) e
+	// depois pela métrica
+	Containers map[string]map[string]stats
+}
+
+func aggregate(runs []*run) ([]*group, error) {
+	type key struct{ Protocol, Mode string }
+	samples := map[key]map[string][]float64{}
+	containerSamples := map[key]map[string]map[string][]float64{}
+
+	for _, r := range runs {
+		k := key{r.Protocol, r.Mode}
+		if samples[k] == nil {
+			samples[k] = map[string][]float64{}
+			containerSamples[k] = map[string]map[string][]float64{}
+		}
+
+		duration, ok := r.Summary.Metrics["http_req_duration"]
+		if !ok {
+			return nil, fmt.Errorf("%s-%s-%d: sem http_req_duration", r.Protocol, r.Mode, r.Number)
+		}
+		samples[k][metricLatencyAvg] = append(samples[k][metricLatencyAvg], duration.Values["avg"])
+		samples[k][metricLatencyP90] = append(samples[k][metricLatencyP90], duration.Values["p(90)"])
+		samples[k][metricThroughput] = append(samples[k][metricThroughput], r.Summary.Metrics["http_reqs"].Values["rate"])
+		samples[k][metricErrorRate] = append(samples[k][metricErrorRate], r.Summary.Metrics["http_req_failed"].Values["rate"])
+
+		panels := map[string]grafana.Panel{metricCPU: r.CPU}
+		if r.Network != nil {
+			panels[metricRX], panels[metricTX] = r.Network.RX, r.Network.TX
+		}
+		for metric, panel := range panels {
+			for container, series := range panel {
+				role := containerRole(container)
+				if containerSamples[k][role] == nil {
+					containerSamples[k][role] = map[string][]float64{}
+				}
+				containerSamples[k][role][metric] = append(containerSamples[k][role][metric], series.Mean())
+			}
+		}
+	}
+
+	var groups []*group
+	for k, metricSamples := range samples {
+		g := &group{
+			Protocol:   k.Protocol,
+			Mode:       k.Mode,
+			Runs:       len(metricSamples[metricLatencyAvg]),
+			Metrics:    map[string]stats{},
+			Containers: map[string]map[string]stats{},
+		}
+		for metric, values := range metricSamples {
+			g.Metrics[metric] = describe(values)
+		}
+		for role, byMetric := range containerSamples[k] {
+			g.Containers[role] = map[string]stats{}
+			for metric, values := range byMetric {
+				g.Containers[role][metric] = describe(values)
+			}
+		}
+		groups = append(groups, g)
+	}
+
+	slices.SortFunc(groups, func(a, b *group) int {
+		if c := protocolOrder(a.Protocol) - protocolOrder(b.Protocol); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Protocol, b.Protocol); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Mode, b.Mode)
+	})
+	return groups, nil
+}
+
+// containerRole remove o sufixo da stack: bff-grpc-api e bff-api viram bff
+func containerRole(container string) string {
+	role, _, _ := strings.Cut(container, "-")
+	return role
+}
+
+var protocols = []string{"json", "msgpack", "cbor", "protobuf", "grpc"}
+
+func protocolOrder(protocol string) int {
+	if i := slices.Index(protocols, protocol); i >= 0 {
+		return i
+	}
+	return len(protocols)
+}
+
+// roles devolve os containers presentes em algum grupo, em ordem alfabética
+func roles(groups []*group) []string {
+	var result []string
+	for _, g := range groups {
+		for role := range g.Containers {
+			if !slices.Contains(result, role) {
+				result = append(result, role)
+			}
+		}
+	}
+	slices.Sort(result)
+	return result
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestRunFile(t *testing.T) {
+	for name, want := range map[string][]string{
+		"resultado-grpc-1.summary.json":              {"grpc", "", "1", "summary.json"},
+		"resultado-json-lote-12.consolidado.json":    {"json", "lote", "12", "consolidado.json"},
+		"resultado-msgpack-multiplexado-3.cpu.csv":   {"msgpack", "multiplexado", "3", "cpu.csv"},
+		"resultado-cbor-sequencial-2.rxtx-pod-6.csv": {"cbor", "sequencial", "2", "rxtx-pod-6.csv"},
+		"resultado-grpc-outro-1.summary.json":        nil,
+		"resultado-grpc-1.grafana.png":               nil,
+		"resultado-grpc.summary.json":                nil,
+	} {
+		m := runFile.FindStringSubmatch(name)
+		if want == nil {
+			if m != nil {
+				t.Errorf("%s: %q, esperado sem correspondência", name, m)
+			}
+			continue
+		}
+		if m == nil || !slices.Equal(m[1:], want) {
+			t.Errorf("%s: %q, esperado %q", name, m, want)
+		}
+	}
+}
+
+// copyFixture copia um arquivo dos resultados gravados para dir com outro nome
+func copyFixture(t *testing.T, dir, src, name string) {
+	t.Helper()
+	data, err := os.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Os resultados gravados do gRPC, com o painel de rede completo, e do JSON,
+// exportado por pod, mais execuções de outros modos montadas a partir deles
+func TestLoadRuns(t *testing.T) {
+	grpcDir, jsonDir := "../../../GRPC/SCRIPTS", "../../../JSON/SCRIPTS"
+	dir := t.TempDir()
+	copyFixture(t, dir, grpcDir+"/resultado-grpc-1.summary.json", "resultado-grpc-lote-1.summary.json")
+	copyFixture(t, dir, grpcDir+"/resultado-grpc-2.consolidado.json", "resultado-grpc-lote-2.consolidado.json")
+	copyFixture(t, dir, grpcDir+"/resultado-grpc-1.cpu.csv", "resultado-grpc-lote-2.cpu.csv")
+	// sem summary a execução fica de fora
+	copyFixture(t, dir, grpcDir+"/resultado-grpc-1.cpu.csv", "resultado-grpc-lote-3.cpu.csv")
+
+	runs, err := loadRuns([]string{grpcDir, jsonDir, dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []runKey
+	for _, r := range runs {
+		keys = append(keys, r.runKey)
+	}
+	want := []runKey{
+		{"grpc", "lote", 1}, {"grpc", "lote", 2},
+		{"grpc", "paralelo", 1}, {"grpc", "paralelo", 2}, {"grpc", "paralelo", 3}, {"grpc", "paralelo", 4}, {"grpc", "paralelo", 5},
+		{"json", "paralelo", 1}, {"json", "paralelo", 2}, {"json", "paralelo", 3}, {"json", "paralelo", 4},
+	}
+	if !slices.Equal(keys, want) {
+		t.Fatalf("%v, esperado %v", keys, want)
+	}
+
+	for _, r := range runs {
+		if r.Summary == nil {
+			t.Errorf("%v sem summary", r.runKey)
+		}
+		// o painel por pod do JSON não vira rede
+		wantNetwork := r.Protocol == "grpc" && r.Mode == defaultMode
+		if (r.Network != nil) != wantNetwork {
+			t.Errorf("%v: rede %v, esperado %v", r.runKey, r.Network != nil, wantNetwork)
+		}
+	}
+
+	groups, err := aggregate(runs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var order []string
+	for _, g := range groups {
+		order = append(order, g.Protocol+"-"+g.Mode)
+	}
+	if want := []string{"json-paralelo", "grpc-lote", "grpc-paralelo"}; !slices.Equal(order, want) {
+		t.Fatalf("grupos %v, esperado %v", order, want)
+	}
+
+	grpc := groups[2]
+	if grpc.Runs != 5 || grpc.Metrics[metricLatencyAvg].N != 5 {
+		t.Errorf("grpc-paralelo com %d execuções, esperado 5", grpc.Runs)
+	}
+	// bff-grpc-api e os demais containers ficam só com o papel
+	if got, want := roles(groups[2:]), []string{"bff", "brands", "categories", "images", "products", "sellers"}; !slices.Equal(got, want) {
+		t.Errorf("papéis %v, esperado %v", got, want)
+	}
+	if n := grpc.Containers["bff"][metricRX].N; n != 5 {
+		t.Errorf("rx do bff em %d execuções, esperado 5", n)
+	}
+	if _, ok := groups[0].Containers["bff"][metricRX]; ok {
+		t.Error("json-paralelo com rede a partir do painel por pod")
+	}
+	if n := groups[1].Containers["bff"][metricCPU].N; n != 1 {
+		t.Errorf("cpu do bff em grpc-lote em %d execuções, esperado 1", n)
+	}
+}
+
+func TestContainerRole(t *testing.T) {
+	for container, want := range map[string]string{
+		"bff-grpc-api":      "bff",
+		"bff-api":           "bff",
+		"products-grpc-api": "products",
+		"sellers":           "sellers",
+	} {
+		if got := containerRole(container); got != want {
+			t.Errorf("%s: %s, esperado %s", container, got, want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import "math"
+
+// stats resume as execuções de uma mesma configuração
+type stats struct {
+	N      int
+	Mean   float64
+	StdDev float64
+	// CILow e CIHigh delimitam o intervalo de confiança de 95% da média
+	CILow  float64
+	CIHigh float64
+}
+
+// tCritical95 são os valores críticos bicaudais da distribuição t de
+// Student para 95%, indexados por graus de liberdade
+var tCritical95 = []float64{
+	0, 12.706, 4.303, 3.182, 2.776, 2.571, 2.447, 2.365, 2.306, 2.262, 2.228,
+	2.201, 2.179, 2.160, 2.145, 2.131, 2.120, 2.110, 2.101, 2.093, 2.086,
+	2.080, 2.074, 2.069, 2.064, 2.060, 2.056, 2.052, 2.048, 2.045, 2.042,
+}
+
+func describe(samples []float64) stats {
+	s := stats{N: len(samples)}
+	if s.N == 0 {
+		return s
+	}
+
+	for _, v := range samples {
+		s.Mean += v
+	}
+	s.Mean /= float64(s.N)
+	s.CILow, s.CIHigh = s.Mean, s.Mean
+	if s.N == 1 {
+		return s
+	}
+
+	var sq float64
+	for _, v := range samples {
+		sq += (v - s.Mean) * (v - s.Mean)
+	}
+	s.StdDev = math.Sqrt(sq / float64(s.N-1))
+
+	t := 1.96
+	if df := s.N - 1; df < len(tCritical95) {
+		t = tCritical95[df]
+	}
+	margin := t * s.StdDev / math.Sqrt(float64(s.N))
+	s.CILow, s.CIHigh = s.Mean-margin, s.Mean+margin
+	return s
+}
+
+func (s stats) margin() float64 {
+	return (s.CIHigh - s.CILow) / 2
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	alternating := make([]float64, 40)
+	for i := range alternating {
+		alternating[i] = float64(i % 2 * 2)
+	}
+
+	for _, tt := range []struct {
+		name    string
+		samples []float64
+		want    stats
+	}{
+		{name: "vazio", want: stats{}},
+		// uma execução não tem desvio: o intervalo é a própria média
+		{name: "N=1", samples: []float64{3}, want: stats{N: 1, Mean: 3, CILow: 3, CIHigh: 3}},
+		// df=1 usa t=12.706 e o desvio amostral divide por N-1
+		{name: "N=2", samples: []float64{1, 3}, want: stats{N: 2, Mean: 2, StdDev: math.Sqrt2, CILow: 2 - 12.706, CIHigh: 2 + 12.706}},
+		// df=7, t=2.365; o desvio populacional seria 2
+		{name: "N=8", samples: []float64{2, 4, 4, 4, 5, 5, 7, 9}, want: stats{N: 8, Mean: 5, StdDev: 2.1380899, CILow: 5 - 1.7877720, CIHigh: 5 + 1.7877720}},
+		// df=39 passa da tabela e cai na normal, t=1.96
+		{name: "N=40", samples: alternating, want: stats{N: 40, Mean: 1, StdDev: 1.0127394, CILow: 1 - 0.3138512, CIHigh: 1 + 0.3138512}},
+	} {
+		got := describe(tt.samples)
+		if got.N != tt.want.N || !near(got.Mean, tt.want.Mean) || !near(got.StdDev, tt.want.StdDev) ||
+			!near(got.CILow, tt.want.CILow) || !near(got.CIHigh, tt.want.CIHigh) {
+			t.Errorf("%s: %+v, esperado %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
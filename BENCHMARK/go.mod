@@ -0,0 +1,3 @@
+module benchmark
+
+go 1.24.1
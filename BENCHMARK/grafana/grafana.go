@@ -0,0 +1,230 @@
+// Package grafana lê os CSVs exportados dos painéis de CPU e de rede do
+// Grafana (resultado-*.cpu.csv e resultado-*.rxtx.csv) em séries temporais
+// por container.
+package grafana
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeLayout é o formato da coluna Time das exportações
+const TimeLayout = "2006-01-02 15:04:05"
+
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Series é a série de um container. Células vazias da exportação (container
+// sem amostra naquele instante) não viram pontos.
+type Series struct {
+	Container string
+	Points    []Point
+}
+
+func (s Series) Values() []float64 {
+	values := make([]float64, len(s.Points))
+	for i, p := range s.Points {
+		values[i] = p.Value
+	}
+	return values
+}
+
+func (s Series) Mean() float64 {
+	if len(s.Points) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, p := range s.Points {
+		sum += p.Value
+	}
+	return sum / float64(len(s.Points))
+}
+
+// Panel são as séries de um painel indexadas pelo nome do container
+type Panel map[string]Series
+
+// Containers devolve os nomes dos containers em ordem alfabética
+func (p Panel) Containers() []string {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Network separa o painel de rede em recebido e enviado, em bytes/s
+type Network struct {
+	RX Panel
+	TX Panel
+}
+
+// ReadCPU lê um resultado-*.cpu.csv; os valores são núcleos de CPU
+func ReadCPU(path string) (Panel, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	panel, err := ParseCPU(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return panel, nil
+}
+
+func ParseCPU(r io.Reader) (Panel, error) {
+	t, err := readTable(r)
+	if err != nil {
+		return nil, err
+	}
+	return t.panel(0, len(t.containers), parseFloat)
+}
+
+// ReadNetwork lê um resultado-*.rxtx.csv
+func ReadNetwork(path string) (*Network, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	network, err := ParseNetwork(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return network, nil
+}
+
+// ParseNetwork lê o painel de rede. O Grafana exporta as colunas de rx e
+// depois as de tx com o mesmo cabeçalho, então a primeira metade é rx.
+func ParseNetwork(r io.Reader) (*Network, error) {
+	t, err := readTable(r)
+	if err != nil {
+		return nil, err
+	}
+	half := len(t.containers) / 2
+	if len(t.containers)%2 != 0 || !slices.Equal(t.containers[:half], t.containers[half:]) {
+		return nil, fmt.Errorf("esperadas as mesmas colunas para rx e tx, encontrado %v", t.containers)
+	}
+
+	rx, err := t.panel(0, half, ParseRate)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := t.panel(half, len(t.containers), ParseRate)
+	if err != nil {
+		return nil, err
+	}
+	return &Network{RX: rx, TX: tx}, nil
+}
+
+type table struct {
+	containers []string
+	times      []time.Time
+	// rows sem a coluna Time
+	rows [][]string
+}
+
+func readTable(r io.Reader) (*table, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 || len(records[0]) < 2 || records[0][0] != "Time" {
+		return nil, fmt.Errorf("cabeçalho do Grafana não encontrado")
+	}
+
+	t := &table{}
+	for _, h := range records[0][1:] {
+		t.containers = append(t.containers, containerName(h))
+	}
+	for i, record := range records[1:] {
+		at, err := time.Parse(TimeLayout, record[0])
+		if err != nil {
+			return nil, fmt.Errorf("linha %d: horário inválido %q", i+2, record[0])
+		}
+		t.times = append(t.times, at)
+		t.rows = append(t.rows, record[1:])
+	}
+	return t, nil
+}
+
+func (t *table) panel(from, to int, parse func(string) (float64, error)) (Panel, error) {
+	panel := Panel{}
+	for col := from; col < to; col++ {
+		s := Series{Container: t.containers[col]}
+		for i, row := range t.rows {
+			if strings.TrimSpace(row[col]) == "" {
+				continue
+			}
+			v, err := parse(row[col])
+			if err != nil {
+				return nil, fmt.Errorf("linha %d, %s: %w", i+2, s.Container, err)
+			}
+			s.Points = append(s.Points, Point{Time: t.times[i], Value: v})
+		}
+		panel[s.Container] = s
+	}
+	return panel, nil
+}
+
+// containerName extrai bff-grpc-api de {container_name="bff-grpc-api"}; outros
+// cabeçalhos ficam como estão
+func containerName(header string) string {
+	if name, ok := strings.CutPrefix(header, `{container_name="`); ok {
+		if name, ok := strings.CutSuffix(name, `"}`); ok {
+			return name
+		}
+	}
+	return header
+}
+
+func parseFloat(text string) (float64, error) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+	if err != nil {
+		return 0, fmt.Errorf("valor inválido %q", text)
+	}
+	return v, nil
+}
+
+// Unidades de taxa do Grafana: bytes/sec(SI) usa múltiplos de 1000 e
+// bytes/sec(IEC), múltiplos de 1024
+var rateUnits = map[string]float64{
+	"B/s":   1,
+	"kB/s":  1e3,
+	"MB/s":  1e6,
+	"GB/s":  1e9,
+	"TB/s":  1e12,
+	"KiB/s": 1 << 10,
+	"MiB/s": 1 << 20,
+	"GiB/s": 1 << 30,
+	"TiB/s": 1 << 40,
+}
+
+// ParseRate converte valores como "37.0 B/s", "1.25 MB/s" ou "2 KiB/s" para
+// bytes/s
+func ParseRate(text string) (float64, error) {
+	number, unit, found := strings.Cut(strings.TrimSpace(text), " ")
+	v, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("taxa inválida %q", text)
+	}
+	if !found {
+		return v, nil
+	}
+	mult, ok := rateUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("unidade desconhecida %q", unit)
+	}
+	return v * mult, nil
+}
@@ -0,0 +1,122 @@
+package grafana
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRate(t *testing.T) {
+	for text, want := range map[string]float64{
+		"0":          0,
+		"0 B/s":      0,
+		"37.0 B/s":   37,
+		" 7.86 B/s ": 7.86,
+		"201 kB/s":   201e3,
+		"1.05 MB/s":  1.05e6,
+		"2 GB/s":     2e9,
+		"1 KiB/s":    1024,
+		"1.5 MiB/s":  1.5 * 1024 * 1024,
+		"3 GiB/s":    3 << 30,
+	} {
+		got, err := ParseRate(text)
+		if err != nil || !near(got, want) {
+			t.Errorf("%q: %v %v, esperado %v", text, got, err, want)
+		}
+	}
+
+	for _, text := range []string{"", "abc B/s", "1 kb/s", "1 bits/s"} {
+		if _, err := ParseRate(text); err == nil {
+			t.Errorf("%q aceito", text)
+		}
+	}
+}
+
+// O painel completo da stack gRPC traz as seis colunas de rx e depois as
+// seis de tx, com taxas em B/s, kB/s e MB/s
+func TestReadNetwork(t *testing.T) {
+	network, err := ReadNetwork("../../GRPC/SCRIPTS/resultado-grpc-1.rxtx.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	containers := []string{"bff-grpc-api", "brands-grpc-api", "categories-grpc-api", "images-grpc-api", "products-grpc-api", "sellers-grpc-api"}
+	if got := network.RX.Containers(); strings.Join(got, ",") != strings.Join(containers, ",") {
+		t.Fatalf("rx %v, esperado %v", got, containers)
+	}
+	if got := network.TX.Containers(); strings.Join(got, ",") != strings.Join(containers, ",") {
+		t.Fatalf("tx %v, esperado %v", got, containers)
+	}
+
+	tests := []struct {
+		at             string
+		container      string
+		wantRX, wantTX float64
+	}{
+		{"2025-09-08 19:17:15", "bff-grpc-api", 37, 37},
+		{"2025-09-08 19:18:00", "bff-grpc-api", 201e3, 259e3},
+		{"2025-09-08 19:18:00", "sellers-grpc-api", 20.9e3, 22.8e3},
+		{"2025-09-08 19:18:30", "bff-grpc-api", 1.05e6, 1.35e6},
+		{"2025-09-08 19:18:30", "products-grpc-api", 124e3, 215e3},
+	}
+	for _, tt := range tests {
+		rx := valueAt(t, network.RX[tt.container], tt.at)
+		tx := valueAt(t, network.TX[tt.container], tt.at)
+		if !near(rx, tt.wantRX) || !near(tx, tt.wantTX) {
+			t.Errorf("%s %s: rx %v tx %v, esperado %v e %v", tt.container, tt.at, rx, tx, tt.wantRX, tt.wantTX)
+		}
+	}
+	if n := len(network.RX["bff-grpc-api"].Points); n != 12 {
+		t.Errorf("%d pontos, esperado 12", n)
+	}
+}
+
+// As exportações por pod das stacks HTTP têm uma coluna só, sem o par rx/tx
+func TestReadNetworkPod(t *testing.T) {
+	if _, err := ReadNetwork("../../JSON/SCRIPTS/resultado-json-1.rxtx-pod-1.csv"); err == nil {
+		t.Error("painel de um pod aceito como rx e tx")
+	}
+}
+
+func TestReadCPU(t *testing.T) {
+	panel, err := ReadCPU("../../GRPC/SCRIPTS/resultado-grpc-1.cpu.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(panel) != 6 {
+		t.Fatalf("%d containers, esperado 6", len(panel))
+	}
+	if got := valueAt(t, panel["bff-grpc-api"], "2025-09-08 19:17:15"); !near(got, 0.0000971) {
+		t.Errorf("%v, esperado 0.0000971", got)
+	}
+}
+
+func TestParseNetworkEmptyCells(t *testing.T) {
+	csv := "\"Time\",\"a\",\"a\"\n2025-09-08 19:17:15,1 kB/s,\n2025-09-08 19:17:30,,2 KiB/s\n"
+	network, err := ParseNetwork(strings.NewReader(csv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rx, tx := network.RX["a"].Values(), network.TX["a"].Values(); len(rx) != 1 || rx[0] != 1000 || len(tx) != 1 || tx[0] != 2048 {
+		t.Errorf("rx %v tx %v, esperado [1000] e [2048]", rx, tx)
+	}
+}
+
+func valueAt(t *testing.T, s Series, at string) float64 {
+	t.Helper()
+	when, err := time.Parse(TimeLayout, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range s.Points {
+		if p.Time.Equal(when) {
+			return p.Value
+		}
+	}
+	t.Fatalf("%s sem ponto em %s", s.Container, at)
+	return 0
+}
+
+func near(got, want float64) bool {
+	return math.Abs(got-want) <= 1e-9*math.Max(1, math.Abs(want))
+}
@@ -0,0 +1,25 @@
+// Package env lê os valores padrão das flags das ferramentas do BENCHMARK a
+// partir das mesmas variáveis de ambiente do docker-compose.
+package env
+
+import (
+	"os"
+	"strconv"
+)
+
+// String devolve a variável key, ou fallback quando ela está vazia
+func String(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// Int devolve a variável key como inteiro, ou fallback quando ela está vazia
+// ou não é um número
+func Int(key string, fallback int) int {
+	if v, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,23 @@
+package env
+
+import "testing"
+
+func TestString(t *testing.T) {
+	t.Setenv("BASE_URL", "")
+	if got := String("BASE_URL", "http://localhost:8080"); got != "http://localhost:8080" {
+		t.Errorf("vazio: %q", got)
+	}
+	t.Setenv("BASE_URL", "http://bff:8080")
+	if got := String("BASE_URL", "http://localhost:8080"); got != "http://bff:8080" {
+		t.Errorf("definido: %q", got)
+	}
+}
+
+func TestInt(t *testing.T) {
+	for value, want := range map[string]int{"": 100, "abc": 100, "1.5": 100, "250": 250, "-1": -1} {
+		t.Setenv("CATALOG_SIZE", value)
+		if got := Int("CATALOG_SIZE", 100); got != want {
+			t.Errorf("%q: %d, esperado %d", value, got, want)
+		}
+	}
+}
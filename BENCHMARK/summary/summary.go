@@ -0,0 +1,247 @@
+// Package summary descreve os resumos de execução no mesmo layout que o k6
+// grava: o handleSummary (resultado-*.summary.json) e o --summary-export
+// (resultado-*.consolidado.json).
+package summary
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+const (
+	TypeCounter = "counter"
+	TypeGauge   = "gauge"
+	TypeRate    = "rate"
+	TypeTrend   = "trend"
+
+	ContainsDefault = "default"
+	ContainsTime    = "time"
+	ContainsData    = "data"
+)
+
+// TrendStats são as estatísticas calculadas para métricas do tipo trend,
+// iguais ao summaryTrendStats usado nas execuções com k6
+var TrendStats = []string{"avg", "min", "max", "p(90)"}
+
+type Summary struct {
+	Options   Options           `json:"options"`
+	State     State             `json:"state"`
+	Metrics   map[string]Metric `json:"metrics"`
+	RootGroup Group             `json:"root_group"`
+}
+
+type Options struct {
+	SummaryTimeUnit   string   `json:"summaryTimeUnit"`
+	NoColor           bool     `json:"noColor"`
+	SummaryTrendStats []string `json:"summaryTrendStats"`
+}
+
+type State struct {
+	IsStdErrTTY       bool    `json:"isStdErrTTY"`
+	TestRunDurationMs float64 `json:"testRunDurationMs"`
+	IsStdOutTTY       bool    `json:"isStdOutTTY"`
+}
+
+type Metric struct {
+	Type       string               `json:"type"`
+	Contains   string               `json:"contains"`
+	Values     map[string]float64   `json:"values"`
+	Thresholds map[string]Threshold `json:"thresholds,omitempty"`
+}
+
+type Threshold struct {
+	OK bool `json:"ok"`
+}
+
+type Group struct {
+	Name   string  `json:"name"`
+	Path   string  `json:"path"`
+	ID     string  `json:"id"`
+	Groups []Group `json:"groups"`
+	Checks []Check `json:"checks"`
+}
+
+type Check struct {
+	ID     string `json:"id"`
+	Passes int    `json:"passes"`
+	Fails  int    `json:"fails"`
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+}
+
+// NewRootGroup cria o grupo raiz com os checks informados. Os IDs seguem o
+// k6: md5 do caminho do grupo ou do check.
+func NewRootGroup(checks ...Check) Group {
+	for i := range checks {
+		checks[i].Path = "::" + checks[i].Name
+		checks[i].ID = pathID(checks[i].Path)
+	}
+	if checks == nil {
+		checks = []Check{}
+	}
+	return Group{ID: pathID(""), Groups: []Group{}, Checks: checks}
+}
+
+func pathID(path string) string {
+	sum := md5.Sum([]byte(path))
+	return hex.EncodeToString(sum[:])
+}
+
+// Passed informa se todos os thresholds foram atendidos
+func (s *Summary) Passed() bool {
+	for _, m := range s.Metrics {
+		for _, t := range m.Thresholds {
+			if !t.OK {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func ReadFile(path string) (*Summary, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s Summary
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (s *Summary) WriteFile(path string) error {
+	return writeJSON(path, s)
+}
+
+// Export é o formato do --summary-export do k6: valores das métricas no
+// mesmo nível dos thresholds, que indicam se o limite foi violado
+type Export struct {
+	RootGroup ExportGroup               `json:"root_group"`
+	Metrics   map[string]map[string]any `json:"metrics"`
+}
+
+type ExportGroup struct {
+	Name   string                 `json:"name"`
+	Path   string                 `json:"path"`
+	ID     string                 `json:"id"`
+	Groups map[string]ExportGroup `json:"groups"`
+	Checks map[string]Check       `json:"checks"`
+}
+
+func (s *Summary) Export() *Export {
+	e := &Export{
+		RootGroup: exportGroup(s.RootGroup),
+		Metrics:   make(map[string]map[string]any, len(s.Metrics)),
+	}
+
+	for name, m := range s.Metrics {
+		values := make(map[string]any, len(m.Values)+1)
+		for k, v := range m.Values {
+			// No export as métricas rate guardam a taxa em "value"
+			if m.Type == TypeRate && k == "rate" {
+				k = "value"
+			}
+			values[k] = v
+		}
+		if len(m.Thresholds) > 0 {
+			failed := make(map[string]bool, len(m.Thresholds))
+			for expr, t := range m.Thresholds {
+				failed[expr] = !t.OK
+			}
+			values["thresholds"] = failed
+		}
+		e.Metrics[name] = values
+	}
+	return e
+}
+
+func exportGroup(g Group) ExportGroup {
+	e := ExportGroup{
+		Name:   g.Name,
+		Path:   g.Path,
+		ID:     g.ID,
+		Groups: make(map[string]ExportGroup, len(g.Groups)),
+		Checks: make(map[string]Check, len(g.Checks)),
+	}
+	for _, sub := range g.Groups {
+		e.Groups[sub.Name] = exportGroup(sub)
+	}
+	for _, c := range g.Checks {
+		e.Checks[c.Name] = c
+	}
+	return e
+}
+
+// ReadExportFile lê um resultado-*.consolidado.json e o converte para
+// Summary. O export não guarda o tipo das métricas, então ele é deduzido
+// dos valores presentes.
+func ReadExportFile(path string) (*Summary, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var e struct {
+		Metrics map[string]map[string]json.RawMessage `json:"metrics"`
+	}
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, err
+	}
+
+	s := &Summary{Metrics: make(map[string]Metric, len(e.Metrics))}
+	for name, raw := range e.Metrics {
+		m := Metric{Values: make(map[string]float64, len(raw))}
+		for k, v := range raw {
+			if k == "thresholds" {
+				var failed map[string]bool
+				if err := json.Unmarshal(v, &failed); err != nil {
+					return nil, fmt.Errorf("%s: thresholds de %s: %w", path, name, err)
+				}
+				m.Thresholds = make(map[string]Threshold, len(failed))
+				for expr, f := range failed {
+					m.Thresholds[expr] = Threshold{OK: !f}
+				}
+				continue
+			}
+			var f float64
+			if err := json.Unmarshal(v, &f); err != nil {
+				return nil, fmt.Errorf("%s: %s.%s: %w", path, name, k, err)
+			}
+			m.Values[k] = f
+		}
+
+		_, hasPasses := m.Values["passes"]
+		_, hasCount := m.Values["count"]
+		_, hasAvg := m.Values["avg"]
+		switch {
+		case hasPasses:
+			m.Type = TypeRate
+			m.Values["rate"] = m.Values["value"]
+			delete(m.Values, "value")
+		case hasCount:
+			m.Type = TypeCounter
+		case hasAvg:
+			m.Type = TypeTrend
+		default:
+			m.Type = TypeGauge
+		}
+		s.Metrics[name] = m
+	}
+	return s, nil
+}
+
+func (e *Export) WriteFile(path string) error {
+	return writeJSON(path, e)
+}
+
+func writeJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
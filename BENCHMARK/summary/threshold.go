@@ -0,0 +1,44 @@
+package summary
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EvaluateThreshold avalia uma expressão do k6 como "avg<500",
+// "p(90)<1000" ou "rate>100" contra os valores de uma métrica
+func EvaluateThreshold(expr string, values map[string]float64) (bool, error) {
+	for _, op := range []string{"<=", ">=", "==", "!=", "<", ">"} {
+		stat, limitText, found := strings.Cut(expr, op)
+		if !found {
+			continue
+		}
+
+		stat = strings.TrimSpace(stat)
+		limit, err := strconv.ParseFloat(strings.TrimSpace(limitText), 64)
+		if err != nil {
+			return false, fmt.Errorf("threshold %q: limite inválido", expr)
+		}
+		value, ok := values[stat]
+		if !ok {
+			return false, fmt.Errorf("threshold %q: métrica sem o valor %q", expr, stat)
+		}
+
+		switch op {
+		case "<=":
+			return value <= limit, nil
+		case ">=":
+			return value >= limit, nil
+		case "==":
+			return value == limit, nil
+		case "!=":
+			return value != limit, nil
+		case "<":
+			return value < limit, nil
+		default:
+			return value > limit, nil
+		}
+	}
+	return false, fmt.Errorf("threshold %q: operador não encontrado", expr)
+}
@@ -0,0 +1,66 @@
+package clients
+
+import (
+	"context"
+
+	"shared/domain"
+)
+
+// Os clientes devolvem os tipos de domain em todos os transportes, então o
+// JSON do BFF tem o mesmo formato qualquer que seja o protocolo.
+type ProductClient interface {
+	ProductBySlug(ctx context.Context, slug string) (*domain.Product, error)
+	// EnrichedProductBySlug pede o produto já montado pela products-api,
+	// que busca sozinha as entidades nos outros contextos
+	EnrichedProductBySlug(ctx context.Context, slug string) (*domain.EnrichedProduct, error)
+	// StreamProducts chama fn para cada produto do catálogo, na ordem da
+	// products-api, e para no primeiro erro devolvido por fn
+	StreamProducts(ctx context.Context, fn func(*domain.Product) error) error
+}
+
+type BrandClient interface {
+	BrandByID(ctx context.Context, id int) (*domain.Brand, error)
+}
+
+type SellerClient interface {
+	SellerByID(ctx context.Context, id int) (*domain.Seller, error)
+}
+
+type CategoryClient interface {
+	CategoryByID(ctx context.Context, id int) (*domain.Category, error)
+	// CategoriesByIDs busca várias categorias em uma chamada; IDs
+	// inexistentes ficam de fora do resultado
+	CategoriesByIDs(ctx context.Context, ids []int) ([]*domain.Category, error)
+}
+
+type ImageClient interface {
+	ImageByID(ctx context.Context, id int) (*domain.Image, error)
+	ImagesByIDs(ctx context.Context, ids []int) ([]*domain.Image, error)
+}
+
+// Endpoints dos contextos: URL base no HTTP, host:porta no gRPC
+type Endpoints struct {
+	Products   string
+	Brands     string
+	Sellers    string
+	Categories string
+	Images     string
+}
+
+// Backend reúne os clientes de um transporte. A agregação do BFF só
+// conhece estas interfaces, então trocar o protocolo não muda a lógica.
+type Backend struct {
+	Products   ProductClient
+	Brands     BrandClient
+	Sellers    SellerClient
+	Categories CategoryClient
+	Images     ImageClient
+
+	// Close libera as conexões abertas pelo transporte
+	Close func() error
+
+	// Streams busca as entidades por ID em um stream bidirecional por
+	// contexto em vez de uma chamada por entidade. Só o gRPC tem; nil nos
+	// outros transportes.
+	Streams *Backend
+}
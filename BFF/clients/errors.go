@@ -0,0 +1,63 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StatusError é a resposta de um contexto HTTP com status diferente de 200
+type StatusError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("GET %s: status %d", e.URL, e.StatusCode)
+}
+
+// Code classifica a falha de uma chamada a um contexto com os códigos do
+// gRPC, qualquer que seja o transporte, para o BFF responder o mesmo status
+// em todas as stacks
+func Code(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	// o budget ou a desconexão do cliente, antes de qualquer outra causa
+	if errors.Is(err, context.DeadlineExceeded) {
+		return codes.DeadlineExceeded
+	}
+	if errors.Is(err, context.Canceled) {
+		return codes.Canceled
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.StatusCode {
+		case http.StatusNotFound:
+			return codes.NotFound
+		case http.StatusBadRequest:
+			return codes.InvalidArgument
+		case http.StatusServiceUnavailable:
+			return codes.Unavailable
+		case http.StatusGatewayTimeout:
+			return codes.DeadlineExceeded
+		}
+		return codes.Unknown
+	}
+	if st, ok := status.FromError(err); ok {
+		return st.Code()
+	}
+
+	// conexão recusada, DNS e afins: o contexto está fora do ar
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
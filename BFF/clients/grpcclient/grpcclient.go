@@ -0,0 +1,195 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"bff/clients"
+	"shared/domain"
+	brandpb "shared/proto/brand"
+	categorypb "shared/proto/category"
+	imagepb "shared/proto/image"
+	productpb "shared/proto/product"
+	sellerpb "shared/proto/seller"
+)
+
+type client struct {
+	conns []*grpc.ClientConn
+
+	product  productpb.ProductServiceClient
+	brand    brandpb.BrandServiceClient
+	seller   sellerpb.SellerServiceClient
+	category categorypb.CategoryServiceClient
+	image    imagepb.ImageServiceClient
+}
+
+// New cria um cliente gRPC por contexto e devolve o Backend correspondente;
+// opts se somam às credenciais inseguras, por exemplo um dialer de teste. As
+// conexões são abertas na primeira chamada, e um contexto fora do ar aparece
+// como Unavailable nas requisições, não aqui.
+func New(endpoints clients.Endpoints, opts ...grpc.DialOption) (*clients.Backend, error) {
+	c := &client{}
+	dial := func(target string) (*grpc.ClientConn, error) {
+		conn, err := grpc.NewClient(target, append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)...)
+		if err != nil {
+			return nil, err
+		}
+		c.conns = append(c.conns, conn)
+		return conn, nil
+	}
+
+	productConn, err := dial(endpoints.Products)
+	if err != nil {
+		return nil, errors.Join(err, c.close())
+	}
+	brandConn, err := dial(endpoints.Brands)
+	if err != nil {
+		return nil, errors.Join(err, c.close())
+	}
+	sellerConn, err := dial(endpoints.Sellers)
+	if err != nil {
+		return nil, errors.Join(err, c.close())
+	}
+	categoryConn, err := dial(endpoints.Categories)
+	if err != nil {
+		return nil, errors.Join(err, c.close())
+	}
+	imageConn, err := dial(endpoints.Images)
+	if err != nil {
+		return nil, errors.Join(err, c.close())
+	}
+
+	c.product = productpb.NewProductServiceClient(productConn)
+	c.brand = brandpb.NewBrandServiceClient(brandConn)
+	c.seller = sellerpb.NewSellerServiceClient(sellerConn)
+	c.category = categorypb.NewCategoryServiceClient(categoryConn)
+	c.image = imagepb.NewImageServiceClient(imageConn)
+
+	sc := newStreamClient(c)
+	return &clients.Backend{
+		Products:   c,
+		Brands:     c,
+		Sellers:    c,
+		Categories: c,
+		Images:     c,
+		Close:      c.close,
+		Streams: &clients.Backend{
+			Products:   sc,
+			Brands:     sc,
+			Sellers:    sc,
+			Categories: sc,
+			Images:     sc,
+			Close:      c.close,
+		},
+	}, nil
+}
+
+func (c *client) close() error {
+	var errs []error
+	for _, conn := range c.conns {
+		errs = append(errs, conn.Close())
+	}
+	return errors.Join(errs...)
+}
+
+func (c *client) ProductBySlug(ctx context.Context, slug string) (*domain.Product, error) {
+	p, err := c.product.GetProductBySlug(ctx, &productpb.Slug{Slug: slug})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.ToDomain(), nil
+}
+
+// StreamProducts recebe um produto por mensagem de StreamProducts; cancelar
+// ctx ou devolver erro em fn encerra o stream
+func (c *client) StreamProducts(ctx context.Context, fn func(*domain.Product) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.product.StreamProducts(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+	for {
+		p, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(p.ToDomain()); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *client) EnrichedProductBySlug(ctx context.Context, slug string) (*domain.EnrichedProduct, error) {
+	p, err := c.product.GetEnrichedProduct(ctx, &productpb.Slug{Slug: slug})
+	if err != nil {
+		return nil, err
+	}
+	return p.ToDomain(), nil
+}
+
+func (c *client) BrandByID(ctx context.Context, id int) (*domain.Brand, error) {
+	brand, err := c.brand.GetBrandByID(ctx, &brandpb.BrandRequest{Id: int32(id)})
+	if err != nil {
+		return nil, err
+	}
+	return brand.ToDomain(), nil
+}
+
+func (c *client) SellerByID(ctx context.Context, id int) (*domain.Seller, error) {
+	seller, err := c.seller.GetSellerByID(ctx, &sellerpb.SellerId{Id: int32(id)})
+	if err != nil {
+		return nil, err
+	}
+	return seller.ToDomain(), nil
+}
+
+func (c *client) CategoryByID(ctx context.Context, id int) (*domain.Category, error) {
+	category, err := c.category.GetCategoryByID(ctx, &categorypb.CategoryId{Id: int32(id)})
+	if err != nil {
+		return nil, err
+	}
+	return category.ToDomain(), nil
+}
+
+func (c *client) ImageByID(ctx context.Context, id int) (*domain.Image, error) {
+	image, err := c.image.GetImageByID(ctx, &imagepb.ImageId{Id: int32(id)})
+	if err != nil {
+		return nil, err
+	}
+	return image.ToDomain(), nil
+}
+
+func (c *client) CategoriesByIDs(ctx context.Context, ids []int) ([]*domain.Category, error) {
+	list, err := c.category.GetCategoriesByIDs(ctx, &categorypb.CategoryIds{Ids: toInt32(ids)})
+	if err != nil {
+		return nil, err
+	}
+	return list.ToDomain(), nil
+}
+
+func (c *client) ImagesByIDs(ctx context.Context, ids []int) ([]*domain.Image, error) {
+	list, err := c.image.GetImagesByIDs(ctx, &imagepb.ImageIds{Ids: toInt32(ids)})
+	if err != nil {
+		return nil, err
+	}
+	return list.ToDomain(), nil
+}
+
+func toInt32(ids []int) []int32 {
+	result := make([]int32, len(ids))
+	for i, id := range ids {
+		result[i] = int32(id)
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"shared/dataset"
+	"shared/domain"
+	"shared/grpcserver/grpctest"
+	productpb "shared/proto/product"
+)
+
+// catalogProducts serve o StreamProducts a partir do dataset
+type catalogProducts struct {
+	productpb.UnimplementedProductServiceServer
+	products []*productpb.Product
+}
+
+func (s catalogProducts) StreamProducts(_ *emptypb.Empty, stream grpc.ServerStreamingServer[productpb.Product]) error {
+	for _, p := range s.products {
+		if err := stream.Send(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func productClient(t *testing.T, size int, opts ...grpc.ServerOption) (*client, []domain.Product) {
+	t.Helper()
+	cfg := dataset.DefaultConfig()
+	cfg.Size = size
+	products := dataset.Products(cfg)
+	conn := grpctest.Dial(t, func(s *grpc.Server) {
+		productpb.RegisterProductServiceServer(s, catalogProducts{products: productpb.ListFromDomain(products).Products})
+	}, opts...)
+	return &client{product: productpb.NewProductServiceClient(conn)}, products
+}
+
+// StreamProducts entrega o catálogo inteiro, na ordem do servidor
+func TestStreamProducts(t *testing.T) {
+	c, want := productClient(t, catalogSize)
+
+	var got []*domain.Product
+	err := c.StreamProducts(context.Background(), func(p *domain.Product) error {
+		got = append(got, p)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("%d produtos, esperado %d", len(got), len(want))
+	}
+	for i, p := range got {
+		if p.ID != want[i].ID || p.Slug != want[i].Slug {
+			t.Errorf("posição %d: %d %s, esperado %d %s", i, p.ID, p.Slug, want[i].ID, want[i].Slug)
+		}
+	}
+}
+
+// O erro de fn encerra o stream e faz o servidor parar de enviar
+func TestStreamProductsStop(t *testing.T) {
+	const size = 50_000
+	results := make(chan grpctest.StreamResult, 1)
+	c, _ := productClient(t, size, grpctest.RecordStreams(results))
+
+	stop := errors.New("parar")
+	err := c.StreamProducts(context.Background(), func(*domain.Product) error { return stop })
+	if !errors.Is(err, stop) {
+		t.Fatalf("%v, esperado o erro de fn", err)
+	}
+
+	select {
+	case r := <-results:
+		if r.Err == nil || r.Sent >= size {
+			t.Errorf("servidor enviou %d de %d e terminou com %v, esperado erro antes do fim", r.Sent, size, r.Err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("servidor continua enviando depois do erro")
+	}
+}
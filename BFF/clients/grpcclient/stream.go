@@ -0,0 +1,306 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"shared/domain"
+	brandpb "shared/proto/brand"
+	categorypb "shared/proto/category"
+	imagepb "shared/proto/image"
+	sellerpb "shared/proto/seller"
+)
+
+// streamMux multiplexa chamadas concorrentes em um único EnrichStream: cada
+// pedido leva uma tag e a goroutine de leitura entrega a resposta a quem
+// espera por aquela tag. O stream é aberto no primeiro uso e reaberto depois
+// de uma falha, sempre fora do contexto de uma requisição. Toda espera, da
+// abertura ao Send e à resposta, respeita o ctx de quem chama, para um
+// contexto travado não estourar o budget das requisições.
+type streamMux[Req, Res any] struct {
+	open func(ctx context.Context) (grpc.BidiStreamingClient[Req, Res], error)
+	tag  func(*Res) uint64
+
+	mu      sync.Mutex
+	session *session[Req, Res]
+	next    uint64
+}
+
+// session é um stream do mux. A abertura roda em uma goroutine e quem chega
+// enquanto ela não termina espera em ready; os pedidos seguem por sends para
+// a goroutine que faz os Send, que o gRPC não aceita concorrentes.
+type session[Req, Res any] struct {
+	ready  chan struct{}
+	stream grpc.BidiStreamingClient[Req, Res]
+	err    error
+	cancel context.CancelFunc
+
+	sends chan *Req
+	// done fecha quando o stream falha; pending e failed são protegidos
+	// pelo mu do mux
+	done    chan struct{}
+	pending map[uint64]chan *Res
+	failed  bool
+}
+
+var errStreamClosed = status.Error(codes.Unavailable, "stream encerrado antes da resposta")
+
+func (m *streamMux[Req, Res]) call(ctx context.Context, newReq func(tag uint64) *Req) (*Res, error) {
+	m.mu.Lock()
+	s := m.session
+	if s == nil {
+		s = m.start()
+	}
+	m.next++
+	tag := m.next
+	ch := make(chan *Res, 1)
+	s.pending[tag] = ch
+	m.mu.Unlock()
+
+	select {
+	case <-s.ready:
+	case <-ctx.Done():
+		m.forget(s, tag)
+		return nil, ctx.Err()
+	}
+	if s.err != nil {
+		m.forget(s, tag)
+		return nil, s.err
+	}
+
+	select {
+	case s.sends <- newReq(tag):
+	case <-s.done:
+		return nil, errStreamClosed
+	case <-ctx.Done():
+		m.forget(s, tag)
+		return nil, ctx.Err()
+	}
+
+	select {
+	case res, ok := <-ch:
+		if !ok {
+			return nil, errStreamClosed
+		}
+		return res, nil
+	case <-ctx.Done():
+		// o stream é compartilhado: só a espera desta requisição é abandonada
+		m.forget(s, tag)
+		return nil, ctx.Err()
+	}
+}
+
+// start cria a sessão e abre o stream em segundo plano; chamado com mu
+func (m *streamMux[Req, Res]) start() *session[Req, Res] {
+	s := &session[Req, Res]{
+		ready:   make(chan struct{}),
+		sends:   make(chan *Req),
+		done:    make(chan struct{}),
+		pending: map[uint64]chan *Res{},
+	}
+	m.session = s
+
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := m.open(ctx)
+		if err != nil {
+			cancel()
+			s.err = err
+			m.mu.Lock()
+			if m.session == s {
+				m.session = nil
+			}
+			m.mu.Unlock()
+			close(s.ready)
+			return
+		}
+		s.stream, s.cancel = stream, cancel
+		close(s.ready)
+		go m.send(s)
+		go m.receive(s)
+	}()
+	return s
+}
+
+func (m *streamMux[Req, Res]) forget(s *session[Req, Res], tag uint64) {
+	m.mu.Lock()
+	delete(s.pending, tag)
+	m.mu.Unlock()
+}
+
+// fail encerra a sessão: libera quem ainda esperava e deixa o próximo call
+// abrir outro stream
+func (m *streamMux[Req, Res]) fail(s *session[Req, Res]) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.session == s {
+		m.session = nil
+	}
+	if s.failed {
+		return
+	}
+	s.failed = true
+	s.cancel()
+	close(s.done)
+	for tag, ch := range s.pending {
+		close(ch)
+		delete(s.pending, tag)
+	}
+}
+
+func (m *streamMux[Req, Res]) send(s *session[Req, Res]) {
+	for {
+		select {
+		case req := <-s.sends:
+			if err := s.stream.Send(req); err != nil {
+				m.fail(s)
+				return
+			}
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// receive entrega as respostas até o stream falhar
+func (m *streamMux[Req, Res]) receive(s *session[Req, Res]) {
+	for {
+		res, err := s.stream.Recv()
+		if err != nil {
+			m.fail(s)
+			return
+		}
+
+		tag := m.tag(res)
+		m.mu.Lock()
+		ch, ok := s.pending[tag]
+		delete(s.pending, tag)
+		m.mu.Unlock()
+		if ok {
+			ch <- res
+		}
+	}
+}
+
+// streamError refaz o erro que a chamada unária devolveria, com o código,
+// para a resposta do stream seguir o mesmo mapeamento de status das outras
+// rotas
+func streamError(code int32, message string) error {
+	if codes.Code(code) == codes.OK {
+		if message == "" {
+			return nil
+		}
+		return status.Error(codes.Unknown, message)
+	}
+	return status.Error(codes.Code(code), message)
+}
+
+// streamClient troca as buscas por ID dos contextos de entidade pelo
+// EnrichStream; produto e buscas em lote continuam unárias
+type streamClient struct {
+	*client
+
+	brands     *streamMux[brandpb.BrandStreamRequest, brandpb.BrandStreamResponse]
+	sellers    *streamMux[sellerpb.SellerStreamRequest, sellerpb.SellerStreamResponse]
+	categories *streamMux[categorypb.CategoryStreamRequest, categorypb.CategoryStreamResponse]
+	images     *streamMux[imagepb.ImageStreamRequest, imagepb.ImageStreamResponse]
+}
+
+func newStreamClient(c *client) *streamClient {
+	return &streamClient{
+		client: c,
+		brands: &streamMux[brandpb.BrandStreamRequest, brandpb.BrandStreamResponse]{
+			open: func(ctx context.Context) (grpc.BidiStreamingClient[brandpb.BrandStreamRequest, brandpb.BrandStreamResponse], error) {
+				return c.brand.EnrichStream(ctx)
+			},
+			tag: (*brandpb.BrandStreamResponse).GetTag,
+		},
+		sellers: &streamMux[sellerpb.SellerStreamRequest, sellerpb.SellerStreamResponse]{
+			open: func(ctx context.Context) (grpc.BidiStreamingClient[sellerpb.SellerStreamRequest, sellerpb.SellerStreamResponse], error) {
+				return c.seller.EnrichStream(ctx)
+			},
+			tag: (*sellerpb.SellerStreamResponse).GetTag,
+		},
+		categories: &streamMux[categorypb.CategoryStreamRequest, categorypb.CategoryStreamResponse]{
+			open: func(ctx context.Context) (grpc.BidiStreamingClient[categorypb.CategoryStreamRequest, categorypb.CategoryStreamResponse], error) {
+				return c.category.EnrichStream(ctx)
+			},
+			tag: (*categorypb.CategoryStreamResponse).GetTag,
+		},
+		images: &streamMux[imagepb.ImageStreamRequest, imagepb.ImageStreamResponse]{
+			open: func(ctx context.Context) (grpc.BidiStreamingClient[imagepb.ImageStreamRequest, imagepb.ImageStreamResponse], error) {
+				return c.image.EnrichStream(ctx)
+			},
+			tag: (*imagepb.ImageStreamResponse).GetTag,
+		},
+	}
+}
+
+func (c *streamClient) BrandByID(ctx context.Context, id int) (*domain.Brand, error) {
+	res, err := c.brands.call(ctx, func(tag uint64) *brandpb.BrandStreamRequest {
+		return &brandpb.BrandStreamRequest{Tag: tag, Id: int32(id)}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := streamError(res.Code, res.Error); err != nil {
+		return nil, err
+	}
+	if res.Brand == nil {
+		return nil, errors.New("resposta sem brand")
+	}
+	return res.Brand.ToDomain(), nil
+}
+
+func (c *streamClient) SellerByID(ctx context.Context, id int) (*domain.Seller, error) {
+	res, err := c.sellers.call(ctx, func(tag uint64) *sellerpb.SellerStreamRequest {
+		return &sellerpb.SellerStreamRequest{Tag: tag, Id: int32(id)}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := streamError(res.Code, res.Error); err != nil {
+		return nil, err
+	}
+	if res.Seller == nil {
+		return nil, errors.New("resposta sem seller")
+	}
+	return res.Seller.ToDomain(), nil
+}
+
+func (c *streamClient) CategoryByID(ctx context.Context, id int) (*domain.Category, error) {
+	res, err := c.categories.call(ctx, func(tag uint64) *categorypb.CategoryStreamRequest {
+		return &categorypb.CategoryStreamRequest{Tag: tag, Id: int32(id)}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := streamError(res.Code, res.Error); err != nil {
+		return nil, err
+	}
+	if res.Category == nil {
+		return nil, errors.New("resposta sem category")
+	}
+	return res.Category.ToDomain(), nil
+}
+
+func (c *streamClient) ImageByID(ctx context.Context, id int) (*domain.Image, error) {
+	res, err := c.images.call(ctx, func(tag uint64) *imagepb.ImageStreamRequest {
+		return &imagepb.ImageStreamRequest{Tag: tag, Id: int32(id)}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := streamError(res.Code, res.Error); err != nil {
+		return nil, err
+	}
+	if res.Image == nil {
+		return nil, errors.New("resposta sem image")
+	}
+	return res.Image.ToDomain(), nil
+}
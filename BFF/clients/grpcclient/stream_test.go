@@ -0,0 +1,163 @@
+package grpcclient
+
+import (
+	"context"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"bff/clients"
+	"shared/dataset"
+	"shared/grpcserver/grpctest"
+	brandpb "shared/proto/brand"
+)
+
+const catalogSize = 20
+
+// reversedBrands guarda batch pedidos do EnrichStream e responde na ordem
+// inversa da chegada, para as respostas voltarem fora da ordem dos Send. IDs
+// acima de catalogSize dão NotFound.
+type reversedBrands struct {
+	brandpb.UnimplementedBrandServiceServer
+	batch int
+}
+
+func (s reversedBrands) EnrichStream(stream grpc.BidiStreamingServer[brandpb.BrandStreamRequest, brandpb.BrandStreamResponse]) error {
+	var pending []*brandpb.BrandStreamRequest
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		if pending = append(pending, req); len(pending) < s.batch {
+			continue
+		}
+		for _, req := range slices.Backward(pending) {
+			res := &brandpb.BrandStreamResponse{Tag: req.Tag}
+			if req.Id > catalogSize {
+				res.Error, res.Code = "marca não encontrada", int32(codes.NotFound)
+			} else {
+				res.Brand = brandpb.FromDomain(dataset.Brands(catalogSize)[req.Id-1])
+			}
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+		pending = pending[:0]
+	}
+}
+
+// Chamadas simultâneas dividem o stream e cada uma recebe a própria
+// resposta, mesmo fora de ordem; a falha de um ID volta com o código gRPC e
+// não afeta as outras
+func TestStreamMuxConcurrent(t *testing.T) {
+	ids := []int{1, 2, 3, catalogSize + 1, 5, 8, 13, catalogSize}
+	conn := grpctest.Dial(t, func(s *grpc.Server) {
+		brandpb.RegisterBrandServiceServer(s, reversedBrands{batch: len(ids)})
+	})
+	c := newStreamClient(&client{brand: brandpb.NewBrandServiceClient(conn)})
+	brands := dataset.Brands(catalogSize)
+
+	for range 3 {
+		var wg sync.WaitGroup
+		for _, id := range ids {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				brand, err := c.BrandByID(context.Background(), id)
+				if id > catalogSize {
+					if clients.Code(err) != codes.NotFound {
+						t.Errorf("%d: %v, esperado NotFound", id, err)
+					}
+					return
+				}
+				if err != nil || brand.ID != id || brand.Name != brands[id-1].Name {
+					t.Errorf("%d: %+v %v, esperado %+v", id, brand, err, brands[id-1])
+				}
+			}()
+		}
+		wg.Wait()
+	}
+}
+
+// Uma chamada abandonada pelo contexto enquanto espera a resposta não prende
+// as outras
+func TestStreamMuxCanceled(t *testing.T) {
+	conn := grpctest.Dial(t, func(s *grpc.Server) {
+		brandpb.RegisterBrandServiceServer(s, reversedBrands{batch: 2})
+	})
+	c := newStreamClient(&client{brand: brandpb.NewBrandServiceClient(conn)})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.BrandByID(ctx, 1); clients.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("%v, esperado DeadlineExceeded", err)
+	}
+	// o pedido abandonado completa o lote; a resposta dele é descartada
+	if brand, err := c.BrandByID(context.Background(), 2); err != nil || brand.ID != 2 {
+		t.Errorf("%+v %v, esperado a marca 2", brand, err)
+	}
+}
+
+// Com a conexão travada, cada chamada volta no prazo do próprio ctx em vez
+// de esperar a abertura do stream
+func TestStreamMuxOpenHonorsContext(t *testing.T) {
+	conn, err := grpc.NewClient(grpctest.Target,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	c := newStreamClient(&client{brand: brandpb.NewBrandServiceClient(conn)})
+
+	const budget = 100 * time.Millisecond
+	var wg sync.WaitGroup
+	for id := 1; id <= 4; id++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), budget)
+			defer cancel()
+			start := time.Now()
+			_, err := c.BrandByID(ctx, id)
+			if elapsed := time.Since(start); clients.Code(err) != codes.DeadlineExceeded || elapsed > 5*budget {
+				t.Errorf("%d: %v depois de %s, esperado DeadlineExceeded em %s", id, err, elapsed, budget)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// endingBrands encerra o stream sem responder ao primeiro pedido
+type endingBrands struct {
+	brandpb.UnimplementedBrandServiceServer
+}
+
+func (endingBrands) EnrichStream(stream grpc.BidiStreamingServer[brandpb.BrandStreamRequest, brandpb.BrandStreamResponse]) error {
+	_, err := stream.Recv()
+	return err
+}
+
+// O stream encerrado antes da resposta vira Unavailable, como um contexto
+// fora do ar na chamada unária
+func TestStreamMuxClosed(t *testing.T) {
+	conn := grpctest.Dial(t, func(s *grpc.Server) {
+		brandpb.RegisterBrandServiceServer(s, endingBrands{})
+	})
+	c := newStreamClient(&client{brand: brandpb.NewBrandServiceClient(conn)})
+
+	if _, err := c.BrandByID(context.Background(), 1); clients.Code(err) != codes.Unavailable {
+		t.Errorf("%v, esperado Unavailable", err)
+	}
+}
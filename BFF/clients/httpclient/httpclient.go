@@ -0,0 +1,183 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"bff/clients"
+	"shared/domain"
+)
+
+// Codec define o formato usado no corpo das respostas dos contextos
+type Codec struct {
+	ContentType string
+	Unmarshal   func(data []byte, v any) error
+}
+
+var (
+	JSON    = Codec{ContentType: "application/json", Unmarshal: json.Unmarshal}
+	MsgPack = Codec{ContentType: "application/x-msgpack", Unmarshal: msgpack.Unmarshal}
+	CBOR    = Codec{ContentType: "application/cbor", Unmarshal: cbor.Unmarshal}
+)
+
+type client struct {
+	http      *http.Client
+	codec     Codec
+	endpoints clients.Endpoints
+}
+
+// New cria um Backend que busca os contextos via HTTP com o codec informado
+func New(codec Codec, endpoints clients.Endpoints) *clients.Backend {
+	c := &client{
+		http: &http.Client{
+			Transport: &http.Transport{
+				MaxIdleConns:        100,
+				MaxIdleConnsPerHost: 100,
+				IdleConnTimeout:     90 * time.Second,
+				DisableCompression:  false,
+			},
+		},
+		codec:     codec,
+		endpoints: endpoints,
+	}
+
+	return &clients.Backend{
+		Products:   c,
+		Brands:     c,
+		Sellers:    c,
+		Categories: c,
+		Images:     c,
+		Close: func() error {
+			c.http.CloseIdleConnections()
+			return nil
+		},
+	}
+}
+
+func (c *client) fetch(ctx context.Context, url string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", c.codec.ContentType)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &clients.StatusError{URL: url, StatusCode: resp.StatusCode}
+	}
+
+	return c.codec.Unmarshal(body, target)
+}
+
+func (c *client) ProductBySlug(ctx context.Context, slug string) (*domain.Product, error) {
+	var product domain.Product
+	if err := c.fetch(ctx, fmt.Sprintf("%s/products/%s", c.endpoints.Products, slug), &product); err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// StreamProducts lê a lista inteira: os contextos HTTP não têm streaming
+func (c *client) StreamProducts(ctx context.Context, fn func(*domain.Product) error) error {
+	var products []domain.Product
+	if err := c.fetch(ctx, fmt.Sprintf("%s/products", c.endpoints.Products), &products); err != nil {
+		return err
+	}
+	for i := range products {
+		if err := fn(&products[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *client) EnrichedProductBySlug(ctx context.Context, slug string) (*domain.EnrichedProduct, error) {
+	var product domain.EnrichedProduct
+	if err := c.fetch(ctx, fmt.Sprintf("%s/products/%s/enriched", c.endpoints.Products, slug), &product); err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+func (c *client) BrandByID(ctx context.Context, id int) (*domain.Brand, error) {
+	var brand domain.Brand
+	if err := c.fetch(ctx, fmt.Sprintf("%s/brands/%d", c.endpoints.Brands, id), &brand); err != nil {
+		return nil, err
+	}
+	return &brand, nil
+}
+
+func (c *client) SellerByID(ctx context.Context, id int) (*domain.Seller, error) {
+	var seller domain.Seller
+	if err := c.fetch(ctx, fmt.Sprintf("%s/sellers/%d", c.endpoints.Sellers, id), &seller); err != nil {
+		return nil, err
+	}
+	return &seller, nil
+}
+
+func (c *client) CategoryByID(ctx context.Context, id int) (*domain.Category, error) {
+	var category domain.Category
+	if err := c.fetch(ctx, fmt.Sprintf("%s/categories/%d", c.endpoints.Categories, id), &category); err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (c *client) ImageByID(ctx context.Context, id int) (*domain.Image, error) {
+	var image domain.Image
+	if err := c.fetch(ctx, fmt.Sprintf("%s/images/%d", c.endpoints.Images, id), &image); err != nil {
+		return nil, err
+	}
+	return &image, nil
+}
+
+func (c *client) CategoriesByIDs(ctx context.Context, ids []int) ([]*domain.Category, error) {
+	var categories []*domain.Category
+	if err := c.fetch(ctx, fmt.Sprintf("%s/categories?ids=%s", c.endpoints.Categories, joinIDs(ids)), &categories); err != nil {
+		return nil, err
+	}
+	return nonNil(categories), nil
+}
+
+func (c *client) ImagesByIDs(ctx context.Context, ids []int) ([]*domain.Image, error) {
+	var images []*domain.Image
+	if err := c.fetch(ctx, fmt.Sprintf("%s/images?ids=%s", c.endpoints.Images, joinIDs(ids)), &images); err != nil {
+		return nil, err
+	}
+	return nonNil(images), nil
+}
+
+func joinIDs(ids []int) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(id)
+	}
+	return strings.Join(parts, ",")
+}
+
+// nonNil troca a lista nil (null ou lista vazia, dependendo do codec) por uma
+// vazia, para a resposta trazer [] como nos outros modos
+func nonNil[T any](entities []T) []T {
+	if entities == nil {
+		return []T{}
+	}
+	return entities
+}
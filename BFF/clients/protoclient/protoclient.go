@@ -0,0 +1,166 @@
+package protoclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"bff/clients"
+	"shared/domain"
+	brandpb "shared/proto/brand"
+	categorypb "shared/proto/category"
+	imagepb "shared/proto/image"
+	productpb "shared/proto/product"
+	sellerpb "shared/proto/seller"
+)
+
+const contentType = "application/x-protobuf"
+
+// client busca as mensagens do .proto via HTTP/1.1, nas mesmas rotas dos
+// contextos JSON e MessagePack, para medir o protobuf sem o transporte do gRPC
+type client struct {
+	http      *http.Client
+	endpoints clients.Endpoints
+}
+
+func New(endpoints clients.Endpoints) *clients.Backend {
+	c := &client{
+		http: &http.Client{
+			Transport: &http.Transport{
+				MaxIdleConns:        100,
+				MaxIdleConnsPerHost: 100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+		},
+		endpoints: endpoints,
+	}
+
+	return &clients.Backend{
+		Products:   c,
+		Brands:     c,
+		Sellers:    c,
+		Categories: c,
+		Images:     c,
+		Close: func() error {
+			c.http.CloseIdleConnections()
+			return nil
+		},
+	}
+}
+
+func (c *client) fetch(ctx context.Context, url string, target proto.Message) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", contentType)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &clients.StatusError{URL: url, StatusCode: resp.StatusCode}
+	}
+
+	return proto.Unmarshal(body, target)
+}
+
+func (c *client) ProductBySlug(ctx context.Context, slug string) (*domain.Product, error) {
+	product := &productpb.Product{}
+	if err := c.fetch(ctx, fmt.Sprintf("%s/products/%s", c.endpoints.Products, slug), product); err != nil {
+		return nil, err
+	}
+	return product.ToDomain(), nil
+}
+
+// StreamProducts lê a ProductList inteira: os contextos HTTP não têm
+// streaming
+func (c *client) StreamProducts(ctx context.Context, fn func(*domain.Product) error) error {
+	list := &productpb.ProductList{}
+	if err := c.fetch(ctx, fmt.Sprintf("%s/products", c.endpoints.Products), list); err != nil {
+		return err
+	}
+	for _, product := range list.Products {
+		if err := fn(product.ToDomain()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *client) EnrichedProductBySlug(ctx context.Context, slug string) (*domain.EnrichedProduct, error) {
+	product := &productpb.EnrichedProduct{}
+	if err := c.fetch(ctx, fmt.Sprintf("%s/products/%s/enriched", c.endpoints.Products, slug), product); err != nil {
+		return nil, err
+	}
+	return product.ToDomain(), nil
+}
+
+func (c *client) BrandByID(ctx context.Context, id int) (*domain.Brand, error) {
+	brand := &brandpb.Brand{}
+	if err := c.fetch(ctx, fmt.Sprintf("%s/brands/%d", c.endpoints.Brands, id), brand); err != nil {
+		return nil, err
+	}
+	return brand.ToDomain(), nil
+}
+
+func (c *client) SellerByID(ctx context.Context, id int) (*domain.Seller, error) {
+	seller := &sellerpb.Seller{}
+	if err := c.fetch(ctx, fmt.Sprintf("%s/sellers/%d", c.endpoints.Sellers, id), seller); err != nil {
+		return nil, err
+	}
+	return seller.ToDomain(), nil
+}
+
+func (c *client) CategoryByID(ctx context.Context, id int) (*domain.Category, error) {
+	category := &categorypb.Category{}
+	if err := c.fetch(ctx, fmt.Sprintf("%s/categories/%d", c.endpoints.Categories, id), category); err != nil {
+		return nil, err
+	}
+	return category.ToDomain(), nil
+}
+
+func (c *client) ImageByID(ctx context.Context, id int) (*domain.Image, error) {
+	image := &imagepb.Image{}
+	if err := c.fetch(ctx, fmt.Sprintf("%s/images/%d", c.endpoints.Images, id), image); err != nil {
+		return nil, err
+	}
+	return image.ToDomain(), nil
+}
+
+func (c *client) CategoriesByIDs(ctx context.Context, ids []int) ([]*domain.Category, error) {
+	list := &categorypb.CategoryList{}
+	if err := c.fetch(ctx, fmt.Sprintf("%s/categories?ids=%s", c.endpoints.Categories, joinIDs(ids)), list); err != nil {
+		return nil, err
+	}
+	return list.ToDomain(), nil
+}
+
+func (c *client) ImagesByIDs(ctx context.Context, ids []int) ([]*domain.Image, error) {
+	list := &imagepb.ImageList{}
+	if err := c.fetch(ctx, fmt.Sprintf("%s/images?ids=%s", c.endpoints.Images, joinIDs(ids)), list); err != nil {
+		return nil, err
+	}
+	return list.ToDomain(), nil
+}
+
+func joinIDs(ids []int) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(id)
+	}
+	return strings.Join(parts, ",")
+}
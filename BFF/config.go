@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"bff/clients"
+	"bff/clients/grpcclient"
+	"bff/clients/httpclient"
+	"bff/clients/protoclient"
+)
+
+// Endereços padrão de cada transporte, iguais aos container_name dos
+// docker-compose de cada stack
+var defaultEndpoints = map[string]clients.Endpoints{
+	"json": {
+		Products:   "http://products-api:8080",
+		Brands:     "http://brands-api:8080",
+		Sellers:    "http://sellers-api:8080",
+		Categories: "http://categories-api:8080",
+		Images:     "http://images-api:8080",
+	},
+	"msgpack": {
+		Products:   "http://products-msgpack-api:8080",
+		Brands:     "http://brands-msgpack-api:8080",
+		Sellers:    "http://sellers-msgpack-api:8080",
+		Categories: "http://categories-msgpack-api:8080",
+		Images:     "http://images-msgpack-api:8080",
+	},
+	"cbor": {
+		Products:   "http://products-cbor-api:8080",
+		Brands:     "http://brands-cbor-api:8080",
+		Sellers:    "http://sellers-cbor-api:8080",
+		Categories: "http://categories-cbor-api:8080",
+		Images:     "http://images-cbor-api:8080",
+	},
+	"protobuf": {
+		Products:   "http://products-protobuf-api:8080",
+		Brands:     "http://brands-protobuf-api:8080",
+		Sellers:    "http://sellers-protobuf-api:8080",
+		Categories: "http://categories-protobuf-api:8080",
+		Images:     "http://images-protobuf-api:8080",
+	},
+	"grpc": {
+		Products:   "products-grpc-api:8080",
+		Brands:     "brands-grpc-api:8080",
+		Sellers:    "sellers-grpc-api:8080",
+		Categories: "categories-grpc-api:8080",
+		Images:     "images-grpc-api:8080",
+	},
+}
+
+type config struct {
+	Transport string
+	Endpoints clients.Endpoints
+	// Budget é o tempo total de uma requisição ao BFF, somando todas as
+	// chamadas aos contextos
+	Budget time.Duration
+	Policy Policy
+}
+
+// loadConfig lê TRANSPORT (json, msgpack, cbor, protobuf ou grpc) e,
+// opcionalmente, o endereço de cada contexto em PRODUCTS_API, BRANDS_API, SELLERS_API,
+// CATEGORIES_API e IMAGES_API, o tempo por requisição em REQUEST_BUDGET
+// (duração do Go, padrão 10s) e a política de falha em FAILURE_POLICY
+// (padrão partial) e REQUIRED_FIELDS (padrão seller,brand)
+func loadConfig() (config, error) {
+	cfg := config{Transport: getenv("TRANSPORT", "json")}
+
+	budget, err := time.ParseDuration(getenv("REQUEST_BUDGET", "10s"))
+	if err != nil || budget <= 0 {
+		return cfg, fmt.Errorf("REQUEST_BUDGET inválido: %q", os.Getenv("REQUEST_BUDGET"))
+	}
+	cfg.Budget = budget
+
+	cfg.Policy, err = parsePolicy(getenv("FAILURE_POLICY", "partial"), getenv("REQUIRED_FIELDS", "seller,brand"))
+	if err != nil {
+		return cfg, err
+	}
+
+	endpoints, ok := defaultEndpoints[cfg.Transport]
+	if !ok {
+		return cfg, fmt.Errorf("transporte desconhecido: %q", cfg.Transport)
+	}
+
+	cfg.Endpoints = clients.Endpoints{
+		Products:   getenv("PRODUCTS_API", endpoints.Products),
+		Brands:     getenv("BRANDS_API", endpoints.Brands),
+		Sellers:    getenv("SELLERS_API", endpoints.Sellers),
+		Categories: getenv("CATEGORIES_API", endpoints.Categories),
+		Images:     getenv("IMAGES_API", endpoints.Images),
+	}
+	return cfg, nil
+}
+
+func newBackend(cfg config) (*clients.Backend, error) {
+	switch cfg.Transport {
+	case "json":
+		return httpclient.New(httpclient.JSON, cfg.Endpoints), nil
+	case "msgpack":
+		return httpclient.New(httpclient.MsgPack, cfg.Endpoints), nil
+	case "cbor":
+		return httpclient.New(httpclient.CBOR, cfg.Endpoints), nil
+	case "protobuf":
+		return protoclient.New(cfg.Endpoints), nil
+	case "grpc":
+		return grpcclient.New(cfg.Endpoints)
+	}
+	return nil, fmt.Errorf("transporte desconhecido: %q", cfg.Transport)
+}
+
+func getenv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
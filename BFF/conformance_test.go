@@ -0,0 +1,257 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+
+	"bff/clients"
+	"bff/clients/grpcclient"
+	"bff/clients/httpclient"
+	"bff/clients/protoclient"
+	brandserver "brands-api/server"
+	categoryserver "categories-api/server"
+	imageserver "images-api/server"
+	productserver "products-api/server"
+	sellerserver "sellers-api/server"
+	"shared/dataset"
+	"shared/enrich"
+	"shared/grpcserver/grpctest"
+	"shared/httpapi"
+	"shared/negotiate"
+	brandpb "shared/proto/brand"
+	categorypb "shared/proto/category"
+	imagepb "shared/proto/image"
+	productpb "shared/proto/product"
+	sellerpb "shared/proto/seller"
+)
+
+// As stacks do teste sobem os contextos de verdade, com o mesmo catálogo: os
+// routers de shared/httpapi em um httptest por formato e os servidores de
+// GRPC/CONTEXTOS em bufconn. Assim a conformidade vale para o que roda em
+// produção, não para uma segunda implementação.
+
+// httpContexts serve os cinco contextos de uma stack HTTP em um só servidor
+// e devolve os endpoints e a função que o derruba
+func httpContexts(t *testing.T, cfg dataset.Config, format negotiate.Format) (clients.Endpoints, func()) {
+	t.Helper()
+	formats := negotiate.Offer(format)
+	root := http.NewServeMux()
+	server := httptest.NewServer(root)
+	t.Cleanup(server.Close)
+	u := server.URL
+
+	mount := func(path string, r *mux.Router, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		root.Handle(path, r)
+		root.Handle(path+"/", r)
+	}
+	brands, err := httpapi.Brands(cfg.Size, formats)
+	mount("/brands", brands, err)
+	sellers, err := httpapi.Sellers(cfg.Size, formats)
+	mount("/sellers", sellers, err)
+	categories, err := httpapi.Categories(cfg.Size, formats)
+	mount("/categories", categories, err)
+	images, err := httpapi.Images(cfg.Size, formats)
+	mount("/images", images, err)
+	products, err := httpapi.Products(cfg, formats, &enrich.Endpoints{Brands: u, Sellers: u, Categories: u, Images: u})
+	mount("/products", products, err)
+
+	return clients.Endpoints{Products: u, Brands: u, Sellers: u, Categories: u, Images: u}, server.Close
+}
+
+// grpcContexts registra os cinco servidores em um servidor gRPC sobre bufconn
+// e devolve o Backend do BFF ligado a ele e a função que derruba o servidor
+func grpcContexts(t *testing.T, cfg dataset.Config) (*clients.Backend, func()) {
+	t.Helper()
+	products := productserver.NewProductServer(cfg)
+	var server *grpc.Server
+	dialer := grpctest.Listen(t, func(s *grpc.Server) {
+		server = s
+		productpb.RegisterProductServiceServer(s, products)
+		brandpb.RegisterBrandServiceServer(s, brandserver.NewBrandServer(cfg.Size))
+		sellerpb.RegisterSellerServiceServer(s, sellerserver.NewSellerServer(cfg.Size))
+		categorypb.RegisterCategoryServiceServer(s, categoryserver.NewCategoryServer(cfg.Size))
+		imagepb.RegisterImageServiceServer(s, imageserver.NewImageServer(cfg.Size))
+	})
+
+	target := grpctest.Target
+	if err := products.EnableEnrichment(productserver.Endpoints{Brands: target, Sellers: target, Categories: target, Images: target}, dialer); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { products.Close() })
+
+	backend, err := grpcclient.New(clients.Endpoints{Products: target, Brands: target, Sellers: target, Categories: target, Images: target}, dialer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { backend.Close() })
+	return backend, server.Stop
+}
+
+// stack é o BFF de um transporte ligado aos seus contextos de teste; stop
+// derruba os contextos
+type stack struct {
+	name    string
+	backend *clients.Backend
+	stop    func()
+}
+
+func newStacks(t *testing.T, cfg dataset.Config) []stack {
+	t.Helper()
+	httpStack := func(name string, format negotiate.Format, client func(clients.Endpoints) *clients.Backend) stack {
+		endpoints, stop := httpContexts(t, cfg, format)
+		return stack{name, client(endpoints), stop}
+	}
+	codec := func(codec httpclient.Codec) func(clients.Endpoints) *clients.Backend {
+		return func(endpoints clients.Endpoints) *clients.Backend { return httpclient.New(codec, endpoints) }
+	}
+
+	grpcBackend, grpcStop := grpcContexts(t, cfg)
+	return []stack{
+		httpStack("json", negotiate.JSON, codec(httpclient.JSON)),
+		httpStack("msgpack", negotiate.MsgPack, codec(httpclient.MsgPack)),
+		httpStack("cbor", negotiate.CBOR, codec(httpclient.CBOR)),
+		httpStack("protobuf", negotiate.Protobuf, protoclient.New),
+		{"grpc", grpcBackend, grpcStop},
+	}
+}
+
+func testBFFConfig(t *testing.T) config {
+	t.Helper()
+	cfg := config{Budget: 5 * time.Second}
+	var err error
+	if cfg.Policy, err = parsePolicy("partial", "seller,brand"); err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+// get devolve o status e o corpo normalizado de uma rota do BFF
+func get(t *testing.T, server *httptest.Server, path string) (int, string) {
+	t.Helper()
+	resp, err := http.Get(server.URL + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var body bytes.Buffer
+	body.ReadFrom(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, body.String()
+	}
+	return resp.StatusCode, normalize(t, body.Bytes())
+}
+
+// normalize decodifica e recodifica o corpo, para a comparação não depender
+// de espaços nem da ordem das chaves
+func normalize(t *testing.T, body []byte) string {
+	t.Helper()
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		t.Fatalf("corpo não é JSON: %v\n%s", err, body)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+// Todas as stacks precisam devolver o mesmo JSON para todo slug em todas as
+// rotas de agregação; a stack JSON é a referência. O /multiplexado, que só a
+// gRPC tem, precisa devolver o mesmo que o /paralelo.
+func TestStacksConform(t *testing.T) {
+	cfg := testConfig()
+	products := dataset.Products(cfg)
+
+	stacks := newStacks(t, cfg)
+	bffs := make([]*httptest.Server, len(stacks))
+	for i, stack := range stacks {
+		bffs[i] = httptest.NewServer(newRouter(stack.backend, testBFFConfig(t)))
+		t.Cleanup(bffs[i].Close)
+	}
+
+	for _, route := range []string{"sequencial", "paralelo", "lote", "servidor"} {
+		for _, product := range products {
+			path := "/" + route + "/" + product.Slug
+			wantStatus, want := get(t, bffs[0], path)
+			if wantStatus != http.StatusOK {
+				t.Fatalf("%s %s: status %d\n%s", stacks[0].name, path, wantStatus, want)
+			}
+			for i := 1; i < len(stacks); i++ {
+				status, got := get(t, bffs[i], path)
+				if status != wantStatus || got != want {
+					t.Errorf("%s %s difere de %s\n%s: %d %s\n%s: %d %s",
+						stacks[i].name, path, stacks[0].name, stacks[0].name, wantStatus, want, stacks[i].name, status, got)
+				}
+			}
+		}
+	}
+
+	multiplexed := 0
+	for i, stack := range stacks {
+		if stack.backend.Streams == nil {
+			continue
+		}
+		multiplexed++
+		for _, product := range products {
+			_, want := get(t, bffs[0], "/paralelo/"+product.Slug)
+			if status, got := get(t, bffs[i], "/multiplexado/"+product.Slug); status != http.StatusOK || got != want {
+				t.Errorf("%s /multiplexado/%s difere do /paralelo de %s\n%d %s\nesperado %s", stack.name, product.Slug, stacks[0].name, status, got, want)
+			}
+		}
+	}
+	if multiplexed == 0 {
+		t.Error("nenhuma stack serve o /multiplexado")
+	}
+}
+
+// A falha ao buscar o produto vira o mesmo status em todas as stacks: 404
+// para slug inexistente, 504 com o budget esgotado e 503 com os contextos
+// fora do ar
+func TestStacksErrorStatus(t *testing.T) {
+	cfg := testConfig()
+	slug := dataset.Products(cfg)[0].Slug
+
+	for _, stack := range newStacks(t, cfg) {
+		routes := []string{"sequencial", "paralelo", "lote", "servidor"}
+		if stack.backend.Streams != nil {
+			routes = append(routes, "multiplexado")
+		}
+
+		bffCfg := testBFFConfig(t)
+		bff := httptest.NewServer(newRouter(stack.backend, bffCfg))
+		t.Cleanup(bff.Close)
+
+		expired := bffCfg
+		expired.Budget = time.Nanosecond
+		expiredBFF := httptest.NewServer(newRouter(stack.backend, expired))
+		t.Cleanup(expiredBFF.Close)
+
+		for _, route := range routes {
+			if status, body := get(t, bff, "/"+route+"/produto-inexistente"); status != http.StatusNotFound {
+				t.Errorf("%s /%s: status %d, esperado 404: %s", stack.name, route, status, body)
+			}
+			if status, body := get(t, expiredBFF, "/"+route+"/"+slug); status != http.StatusGatewayTimeout {
+				t.Errorf("%s /%s com budget esgotado: status %d, esperado 504: %s", stack.name, route, status, body)
+			}
+		}
+
+		stack.stop()
+		for _, route := range routes {
+			if status, body := get(t, bff, "/"+route+"/"+slug); status != http.StatusServiceUnavailable {
+				t.Errorf("%s /%s com os contextos fora do ar: status %d, esperado 503: %s", stack.name, route, status, body)
+			}
+		}
+	}
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+
+	"bff/clients"
+	"shared/domain"
+)
+
+// Response de resposta
+type ProductResponse struct {
+	ID          int                `json:"id"`
+	Name        string             `json:"name"`
+	Slug        string             `json:"slug"`
+	Description string             `json:"description"`
+	Price       domain.Price       `json:"price"`
+	Seller      *domain.Seller     `json:"seller"`
+	Brand       *domain.Brand      `json:"brand"`
+	Categories  []*domain.Category `json:"categories"`
+	Images      []*domain.Image    `json:"images"`
+
+	// Partial indica que alguma entidade ficou de fora; Errors diz qual e
+	// por quê
+	Partial bool         `json:"partial"`
+	Errors  []FieldError `json:"errors,omitempty"`
+
+	mu sync.Mutex
+	// abort cancela as buscas restantes no fail-fast; nil nas outras políticas
+	abort context.CancelFunc
+}
+
+// FieldError é uma entidade que não entrou na resposta. Field é o campo do
+// ProductResponse (seller, brand, categories ou images); ID fica vazio nas
+// falhas que não são de um ID só, como as do /lote e do /servidor.
+type FieldError struct {
+	Field string `json:"field"`
+	ID    int    `json:"id,omitempty"`
+	Error string `json:"error"`
+}
+
+// fail registra a falha de uma entidade; seguro para as goroutines do
+// /paralelo e do /lote
+func (r *ProductResponse) fail(field string, id int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.abort != nil {
+		if r.Partial && clients.Code(err) == codes.Canceled {
+			err = errAborted
+		}
+		r.abort()
+	}
+	r.Partial = true
+	r.Errors = append(r.Errors, FieldError{Field: field, ID: id, Error: err.Error()})
+}
+
+func newProductResponse(ctx context.Context, product *domain.Product) *ProductResponse {
+	abort, _ := ctx.Value(abortKey{}).(context.CancelFunc)
+	return &ProductResponse{
+		ID:          product.ID,
+		Name:        product.Name,
+		Slug:        product.Slug,
+		Description: product.Description,
+		Price:       product.Price,
+		abort:       abort,
+	}
+}
+
+func EnrichProductSequential(ctx context.Context, backend *clients.Backend, slug string) (*ProductResponse, error) {
+	product, err := backend.Products.ProductBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	response := newProductResponse(ctx, product)
+	if response.Seller, err = backend.Sellers.SellerByID(ctx, product.SellerID); err != nil {
+		response.fail("seller", product.SellerID, err)
+	}
+	if response.Brand, err = backend.Brands.BrandByID(ctx, product.BrandID); err != nil {
+		response.fail("brand", product.BrandID, err)
+	}
+
+	response.Categories = make([]*domain.Category, len(product.Categories))
+	for i, id := range product.Categories {
+		if response.Categories[i], err = backend.Categories.CategoryByID(ctx, id); err != nil {
+			response.fail("categories", id, err)
+		}
+	}
+
+	response.Images = make([]*domain.Image, len(product.Images))
+	for i, id := range product.Images {
+		if response.Images[i], err = backend.Images.ImageByID(ctx, id); err != nil {
+			response.fail("images", id, err)
+		}
+	}
+
+	return response, nil
+}
+
+func EnrichProductParallel(ctx context.Context, backend *clients.Backend, slug string) (*ProductResponse, error) {
+	product, err := backend.Products.ProductBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	return enrichParallel(ctx, backend, product), nil
+}
+
+// enrichParallel busca as entidades de um produto já carregado, como o
+// /paralelo; também usada pela exportação
+func enrichParallel(ctx context.Context, backend *clients.Backend, product *domain.Product) *ProductResponse {
+	response := newProductResponse(ctx, product)
+	response.Categories = make([]*domain.Category, len(product.Categories))
+	response.Images = make([]*domain.Image, len(product.Images))
+
+	var wg sync.WaitGroup
+
+	// Uma goroutine por entidade; cada uma escreve só na sua posição
+	wg.Add(2 + len(product.Categories) + len(product.Images))
+
+	go func() {
+		defer wg.Done()
+		var err error
+		if response.Seller, err = backend.Sellers.SellerByID(ctx, product.SellerID); err != nil {
+			response.fail("seller", product.SellerID, err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		var err error
+		if response.Brand, err = backend.Brands.BrandByID(ctx, product.BrandID); err != nil {
+			response.fail("brand", product.BrandID, err)
+		}
+	}()
+
+	for i, id := range product.Categories {
+		go func(i int, id int) {
+			defer wg.Done()
+			var err error
+			if response.Categories[i], err = backend.Categories.CategoryByID(ctx, id); err != nil {
+				response.fail("categories", id, err)
+			}
+		}(i, id)
+	}
+
+	for i, id := range product.Images {
+		go func(i int, id int) {
+			defer wg.Done()
+			var err error
+			if response.Images[i], err = backend.Images.ImageByID(ctx, id); err != nil {
+				response.fail("images", id, err)
+			}
+		}(i, id)
+	}
+
+	wg.Wait()
+
+	return response
+}
+
+// EnrichProductBatch busca categorias e imagens com uma chamada em lote cada,
+// então o número de chamadas não cresce com o tamanho do produto
+func EnrichProductBatch(ctx context.Context, backend *clients.Backend, slug string) (*ProductResponse, error) {
+	product, err := backend.Products.ProductBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	response := newProductResponse(ctx, product)
+
+	var wg sync.WaitGroup
+	wg.Add(4)
+
+	go func() {
+		defer wg.Done()
+		var err error
+		if response.Seller, err = backend.Sellers.SellerByID(ctx, product.SellerID); err != nil {
+			response.fail("seller", product.SellerID, err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		var err error
+		if response.Brand, err = backend.Brands.BrandByID(ctx, product.BrandID); err != nil {
+			response.fail("brand", product.BrandID, err)
+		}
+	}()
+
+	// o lote deixa de fora os IDs inexistentes, então só dá para contar
+	// quantos faltaram
+	go func() {
+		defer wg.Done()
+		var err error
+		if response.Categories, err = backend.Categories.CategoriesByIDs(ctx, product.Categories); err != nil {
+			// mesmo formato do sequencial quando todas falham
+			response.Categories = make([]*domain.Category, len(product.Categories))
+			response.fail("categories", 0, err)
+		} else if missing := len(product.Categories) - len(response.Categories); missing > 0 {
+			response.fail("categories", 0, fmt.Errorf("%d de %d categorias não encontradas", missing, len(product.Categories)))
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		var err error
+		if response.Images, err = backend.Images.ImagesByIDs(ctx, product.Images); err != nil {
+			response.Images = make([]*domain.Image, len(product.Images))
+			response.fail("images", 0, err)
+		} else if missing := len(product.Images) - len(response.Images); missing > 0 {
+			response.fail("images", 0, fmt.Errorf("%d de %d imagens não encontradas", missing, len(product.Images)))
+		}
+	}()
+
+	wg.Wait()
+
+	return response, nil
+}
+
+var errNotReturned = errors.New("não devolvido pela products-api")
+
+// EnrichProductServer pede o produto já montado à products-api, que faz a
+// mesma composição do /paralelo do lado do serviço
+func EnrichProductServer(ctx context.Context, backend *clients.Backend, slug string) (*ProductResponse, error) {
+	enriched, err := backend.Products.EnrichedProductBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProductResponse{
+		ID:          enriched.ID,
+		Name:        enriched.Name,
+		Slug:        enriched.Slug,
+		Description: enriched.Description,
+		Price:       enriched.Price,
+	}
+	response.Seller = enriched.Seller
+	response.Brand = enriched.Brand
+	response.Categories = enriched.Categories
+	response.Images = enriched.Images
+
+	// cada entidade nil é explicada pelo erro da products-api na mesma
+	// posição; sem ele, a falha fica sem motivo e sem ID
+	reported := func(field string, index int) (int, error) {
+		for _, e := range enriched.Errors {
+			if e.Field == field && e.Index == index {
+				return e.ID, errors.New(e.Error)
+			}
+		}
+		return 0, errNotReturned
+	}
+	if response.Seller == nil {
+		id, err := reported("seller", 0)
+		response.fail("seller", id, err)
+	}
+	if response.Brand == nil {
+		id, err := reported("brand", 0)
+		response.fail("brand", id, err)
+	}
+	for i, category := range response.Categories {
+		if category == nil {
+			id, err := reported("categories", i)
+			response.fail("categories", id, err)
+		}
+	}
+	for i, image := range response.Images {
+		if image == nil {
+			id, err := reported("images", i)
+			response.fail("images", id, err)
+		}
+	}
+
+	return response, nil
+}
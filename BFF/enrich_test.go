@@ -0,0 +1,372 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"bff/clients"
+	"shared/dataset"
+	"shared/domain"
+)
+
+// fakeBackend serve o catálogo do dataset com um atraso aleatório por
+// chamada, para que no /paralelo as entidades terminem fora de ordem.
+// IDs em missing respondem erro, como um contexto fora do ar.
+type fakeBackend struct {
+	products   []domain.Product
+	brands     []domain.Brand
+	sellers    []domain.Seller
+	categories []domain.Category
+	images     []domain.Image
+	missing    map[int]bool
+}
+
+func newFakeBackend(cfg dataset.Config, missing ...int) *clients.Backend {
+	f := &fakeBackend{
+		products:   dataset.Products(cfg),
+		brands:     dataset.Brands(cfg.Size),
+		sellers:    dataset.Sellers(cfg.Size),
+		categories: dataset.Categories(cfg.Size),
+		images:     dataset.Images(cfg.Size),
+		missing:    map[int]bool{},
+	}
+	for _, id := range missing {
+		f.missing[id] = true
+	}
+	return &clients.Backend{
+		Products:   f,
+		Brands:     f,
+		Sellers:    f,
+		Categories: f,
+		Images:     f,
+		Close:      func() error { return nil },
+	}
+}
+
+func (f *fakeBackend) delay() {
+	time.Sleep(time.Duration(rand.IntN(500)) * time.Microsecond)
+}
+
+func find[T any](f *fakeBackend, items []T, id int) (*T, error) {
+	f.delay()
+	if f.missing[id] || id < 1 || id > len(items) {
+		return nil, fmt.Errorf("%d não encontrado", id)
+	}
+	return &items[id-1], nil
+}
+
+func findAll[T any](f *fakeBackend, items []T, ids []int) ([]*T, error) {
+	f.delay()
+	result := []*T{}
+	for _, id := range ids {
+		if !f.missing[id] && id >= 1 && id <= len(items) {
+			result = append(result, &items[id-1])
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeBackend) ProductBySlug(ctx context.Context, slug string) (*domain.Product, error) {
+	for i := range f.products {
+		if f.products[i].Slug == slug {
+			return &f.products[i], nil
+		}
+	}
+	return nil, fmt.Errorf("produto %s não encontrado", slug)
+}
+
+// EnrichedProductBySlug monta o produto como a products-api: as entidades
+// que falham ficam nil e vão para Errors com a posição delas
+func (f *fakeBackend) EnrichedProductBySlug(ctx context.Context, slug string) (*domain.EnrichedProduct, error) {
+	product, err := f.ProductBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	enriched := &domain.EnrichedProduct{
+		ID:          product.ID,
+		Name:        product.Name,
+		Slug:        product.Slug,
+		Description: product.Description,
+		Price:       product.Price,
+		Categories:  make([]*domain.Category, len(product.Categories)),
+		Images:      make([]*domain.Image, len(product.Images)),
+	}
+	fail := func(field string, id, index int, err error) {
+		enriched.Errors = append(enriched.Errors, domain.EnrichError{Field: field, ID: id, Index: index, Error: err.Error()})
+	}
+	if enriched.Seller, err = find(f, f.sellers, product.SellerID); err != nil {
+		fail("seller", product.SellerID, 0, err)
+	}
+	if enriched.Brand, err = find(f, f.brands, product.BrandID); err != nil {
+		fail("brand", product.BrandID, 0, err)
+	}
+	for i, id := range product.Categories {
+		if enriched.Categories[i], err = find(f, f.categories, id); err != nil {
+			fail("categories", id, i, err)
+		}
+	}
+	for i, id := range product.Images {
+		if enriched.Images[i], err = find(f, f.images, id); err != nil {
+			fail("images", id, i, err)
+		}
+	}
+	return enriched, nil
+}
+
+func (f *fakeBackend) StreamProducts(ctx context.Context, fn func(*domain.Product) error) error {
+	for i := range f.products {
+		if err := fn(&f.products[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeBackend) BrandByID(ctx context.Context, id int) (*domain.Brand, error) {
+	return find(f, f.brands, id)
+}
+
+func (f *fakeBackend) SellerByID(ctx context.Context, id int) (*domain.Seller, error) {
+	return find(f, f.sellers, id)
+}
+
+func (f *fakeBackend) CategoryByID(ctx context.Context, id int) (*domain.Category, error) {
+	return find(f, f.categories, id)
+}
+
+func (f *fakeBackend) ImageByID(ctx context.Context, id int) (*domain.Image, error) {
+	return find(f, f.images, id)
+}
+
+func (f *fakeBackend) CategoriesByIDs(ctx context.Context, ids []int) ([]*domain.Category, error) {
+	return findAll(f, f.categories, ids)
+}
+
+func (f *fakeBackend) ImagesByIDs(ctx context.Context, ids []int) ([]*domain.Image, error) {
+	return findAll(f, f.images, ids)
+}
+
+// encode devolve o corpo que o handler enviaria
+func encode(t *testing.T, enrich enrichFunc, backend *clients.Backend, slug string) string {
+	t.Helper()
+	response, err := enrich(context.Background(), backend, slug)
+	if err != nil {
+		t.Fatalf("%s: %v", slug, err)
+	}
+	response.sortErrors()
+	data, err := json.Marshal(response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func testConfig() dataset.Config {
+	cfg := dataset.DefaultConfig()
+	cfg.Size = 50
+	cfg.Categories = dataset.Uniform(0, 8)
+	cfg.Images = dataset.Uniform(0, 8)
+	return cfg
+}
+
+// Sequencial, paralelo e lote precisam devolver o mesmo corpo para todo slug,
+// com categorias e imagens na ordem do produto
+func TestModesEquivalent(t *testing.T) {
+	cfg := testConfig()
+	backend := newFakeBackend(cfg)
+
+	for _, product := range dataset.Products(cfg) {
+		sequential := encode(t, EnrichProductSequential, backend, product.Slug)
+		if parallel := encode(t, EnrichProductParallel, backend, product.Slug); parallel != sequential {
+			t.Errorf("%s: paralelo difere do sequencial\nsequencial: %s\nparalelo:   %s", product.Slug, sequential, parallel)
+		}
+		if batch := encode(t, EnrichProductBatch, backend, product.Slug); batch != sequential {
+			t.Errorf("%s: lote difere do sequencial\nsequencial: %s\nlote:       %s", product.Slug, sequential, batch)
+		}
+		if server := encode(t, EnrichProductServer, backend, product.Slug); server != sequential {
+			t.Errorf("%s: servidor difere do sequencial\nsequencial: %s\nservidor:   %s", product.Slug, sequential, server)
+		}
+	}
+}
+
+// Com falhas, o paralelo e o servidor mantêm as posições das entidades que
+// faltaram e listam os mesmos erros do sequencial
+func TestParallelMatchesSequentialWithFailures(t *testing.T) {
+	cfg := testConfig()
+	missing := []int{3, 7, 11, 19, 23}
+	backend := newFakeBackend(cfg, missing...)
+
+	failed := 0
+	for _, product := range dataset.Products(cfg) {
+		sequential := encode(t, EnrichProductSequential, backend, product.Slug)
+		if parallel := encode(t, EnrichProductParallel, backend, product.Slug); parallel != sequential {
+			t.Errorf("%s: paralelo difere do sequencial\nsequencial: %s\nparalelo:   %s", product.Slug, sequential, parallel)
+		}
+		if server := encode(t, EnrichProductServer, backend, product.Slug); server != sequential {
+			t.Errorf("%s: servidor difere do sequencial\nsequencial: %s\nservidor:   %s", product.Slug, sequential, server)
+		}
+		if slices.ContainsFunc(product.Categories, func(id int) bool { return slices.Contains(missing, id) }) {
+			failed++
+		}
+	}
+	if failed == 0 {
+		t.Fatal("nenhum produto referencia as categorias com falha; ajuste o catálogo do teste")
+	}
+}
+
+// blockingCategories só responde quando ctx acaba, como um contexto lento
+type blockingCategories struct{ clients.CategoryClient }
+
+func (blockingCategories) CategoryByID(ctx context.Context, id int) (*domain.Category, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// No fail-fast a primeira falha cancela as buscas em andamento; nas outras
+// políticas elas seguem até o budget
+func TestFailFastCancelsPending(t *testing.T) {
+	cfg := testConfig()
+	products := dataset.Products(cfg)
+	product := products[slices.IndexFunc(products, func(p domain.Product) bool { return len(p.Categories) > 1 })]
+	backend := newFakeBackend(cfg, product.SellerID)
+	backend.Categories = blockingCategories{backend.Categories}
+
+	for mode, want := range map[string]int{"fail-fast": http.StatusBadGateway, "partial": http.StatusOK} {
+		policy, err := parsePolicy(mode, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		router := newRouter(backend, config{Budget: 200 * time.Millisecond, Policy: policy})
+
+		start := time.Now()
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/paralelo/"+product.Slug, nil))
+		elapsed := time.Since(start)
+
+		var body ProductResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil || w.Code != want {
+			t.Fatalf("%s: %d %v, esperado %d", mode, w.Code, err, want)
+		}
+		var categories []string
+		for _, e := range body.Errors {
+			if e.Field == "categories" {
+				categories = append(categories, e.Error)
+			}
+		}
+		if len(categories) != len(product.Categories) {
+			t.Fatalf("%s: %d erros de categoria, esperado %d: %v", mode, len(categories), len(product.Categories), body.Errors)
+		}
+
+		aborted := slices.Contains(categories, errAborted.Error())
+		if mode == "fail-fast" && (!aborted || elapsed >= 200*time.Millisecond) {
+			t.Errorf("fail-fast esperou %s pelas buscas pendentes: %v", elapsed, categories)
+		}
+		if mode == "partial" && (aborted || elapsed < 200*time.Millisecond) {
+			t.Errorf("partial cancelou as buscas em %s: %v", elapsed, categories)
+		}
+	}
+}
+
+// /multiplexado agrega como o /paralelo, mas com as entidades do Backend de
+// streams, e só existe quando o transporte tem um
+func TestMultiplexedRoute(t *testing.T) {
+	cfg := testConfig()
+	products := dataset.Products(cfg)
+	policy, err := parsePolicy("partial", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bff := config{Budget: time.Second, Policy: policy}
+
+	// sem streams, as buscas unárias falham para a rota não as usar por engano
+	all := make([]int, cfg.Size)
+	for i := range all {
+		all[i] = i + 1
+	}
+	backend := newFakeBackend(cfg, all...)
+
+	w := httptest.NewRecorder()
+	newRouter(backend, bff).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/multiplexado/"+products[0].Slug, nil))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("/multiplexado sem streams: status %d, esperado 404", w.Code)
+	}
+
+	backend.Streams = newFakeBackend(cfg, 3, 7)
+	router := newRouter(backend, bff)
+	for _, product := range products {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/multiplexado/"+product.Slug, nil))
+		want := encode(t, EnrichProductParallel, backend.Streams, product.Slug)
+		if got := strings.TrimSpace(w.Body.String()); w.Code != http.StatusOK || got != want {
+			t.Errorf("%s: %d %s\nesperado %s", product.Slug, w.Code, got, want)
+		}
+	}
+}
+
+// O schema publicado precisa listar exatamente os campos que o handler
+// envia, inclusive os das entidades
+func TestSchemaMatchesResponse(t *testing.T) {
+	var schema struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+		Defs       map[string]struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+		} `json:"$defs"`
+	}
+	if err := json.Unmarshal(productResponseSchema, &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := testConfig()
+	backend := newFakeBackend(cfg, 3)
+	var body map[string]json.RawMessage
+	for _, product := range dataset.Products(cfg) {
+		if !slices.Contains(product.Categories, 3) || len(product.Categories) < 2 || len(product.Images) == 0 {
+			continue
+		}
+		if err := json.Unmarshal([]byte(encode(t, EnrichProductSequential, backend, product.Slug)), &body); err != nil {
+			t.Fatal(err)
+		}
+		break
+	}
+	if body == nil {
+		t.Fatal("nenhum produto com falha e imagens; ajuste o catálogo do teste")
+	}
+
+	compareKeys(t, "ProductResponse", schema.Properties, body)
+	entities := map[string]string{"seller": "seller", "brand": "brand", "categories": "category", "images": "image"}
+	for field, def := range entities {
+		raw := body[field]
+		if raw[0] == '[' {
+			var items []json.RawMessage
+			json.Unmarshal(raw, &items)
+			raw = items[slices.IndexFunc(items, func(item json.RawMessage) bool { return string(item) != "null" })]
+		}
+		var entity map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &entity); err != nil {
+			t.Fatal(err)
+		}
+		compareKeys(t, def, schema.Defs[def].Properties, entity)
+	}
+}
+
+func compareKeys(t *testing.T, name string, schema, body map[string]json.RawMessage) {
+	t.Helper()
+	for key := range body {
+		if _, ok := schema[key]; !ok {
+			t.Errorf("%s: campo %q enviado mas fora do schema", name, key)
+		}
+	}
+	for key := range schema {
+		if _, ok := body[key]; !ok {
+			t.Errorf("%s: campo %q do schema não enviado", name, key)
+		}
+	}
+}
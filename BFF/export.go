@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"bff/clients"
+	"shared/domain"
+)
+
+// exportHandler envia o catálogo enriquecido em NDJSON, um produto por linha.
+// No gRPC os produtos chegam do StreamProducts da products-api e a lista
+// inteira nunca fica em memória; nos transportes HTTP a products-api não tem
+// streaming e o catálogo é lido inteiro antes da primeira linha. O budget vale para cada produto; a exportação toda só termina com o
+// catálogo ou com a desconexão do cliente. Como o status sai com a primeira
+// linha, a política de falha não se aplica: cada linha traz partial e errors.
+func exportHandler(backend *clients.Backend, budget time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		flusher, _ := w.(http.Flusher)
+		encoder := json.NewEncoder(w)
+		written := false
+
+		err := backend.Products.StreamProducts(r.Context(), func(product *domain.Product) error {
+			ctx, cancel := context.WithTimeout(r.Context(), budget)
+			defer cancel()
+
+			response := enrichParallel(ctx, backend, product)
+			response.sortErrors()
+			if err := encoder.Encode(response); err != nil {
+				return err
+			}
+			written = true
+			if flusher != nil {
+				flusher.Flush()
+			}
+			return nil
+		})
+		if err == nil {
+			return
+		}
+		if !written {
+			http.Error(w, err.Error(), httpStatus(err))
+			return
+		}
+		// o status 200 já foi enviado junto com as primeiras linhas
+		log.Printf("Exportação interrompida: %v", err)
+	}
+}
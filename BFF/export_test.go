@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"bff/clients"
+	"shared/dataset"
+	"shared/domain"
+)
+
+// /exportar manda uma linha por produto, na ordem do catálogo, com o mesmo
+// corpo do /paralelo
+func TestExport(t *testing.T) {
+	cfg := testConfig()
+	backend := newFakeBackend(cfg, 3, 7)
+
+	w := httptest.NewRecorder()
+	newRouter(backend, config{Budget: time.Second}).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/exportar", nil))
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "application/x-ndjson" {
+		t.Fatalf("status %d, Content-Type %q", w.Code, w.Header().Get("Content-Type"))
+	}
+
+	lines := strings.Split(strings.TrimSuffix(w.Body.String(), "\n"), "\n")
+	products := dataset.Products(cfg)
+	if len(lines) != len(products) {
+		t.Fatalf("%d linhas, esperado %d", len(lines), len(products))
+	}
+	for i, product := range products {
+		if want := encode(t, EnrichProductParallel, backend, product.Slug); lines[i] != want {
+			t.Errorf("linha %d: %s\nesperado %s", i, lines[i], want)
+		}
+	}
+}
+
+// endlessProducts repete o catálogo até ctx acabar, como um stream que não
+// termina sozinho, e avisa em done quando parou
+type endlessProducts struct {
+	clients.ProductClient
+	done chan<- error
+}
+
+func (p endlessProducts) StreamProducts(ctx context.Context, fn func(*domain.Product) error) error {
+	var err error
+	for err == nil {
+		err = p.ProductClient.StreamProducts(ctx, func(product *domain.Product) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			return fn(product)
+		})
+	}
+	p.done <- err
+	return err
+}
+
+// A desconexão do cliente no meio da exportação encerra o stream de produtos
+func TestExportClientCancel(t *testing.T) {
+	backend := newFakeBackend(testConfig())
+	done := make(chan error, 1)
+	backend.Products = endlessProducts{backend.Products, done}
+	bff := httptest.NewServer(newRouter(backend, config{Budget: time.Second}))
+	t.Cleanup(bff.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, bff.URL+"/exportar", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if _, err := bufio.NewReader(resp.Body).ReadString('\n'); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("a exportação continua depois da desconexão do cliente")
+	}
+}
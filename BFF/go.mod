@@ -0,0 +1,37 @@
+module bff
+
+go 1.24.1
+
+require (
+	brands-api v0.0.0
+	categories-api v0.0.0
+	github.com/fxamacker/cbor/v2 v2.9.0
+	github.com/gorilla/mux v1.8.1
+	github.com/vmihailenco/msgpack/v5 v5.4.1
+	google.golang.org/grpc v1.73.0
+	google.golang.org/protobuf v1.36.6
+	images-api v0.0.0
+	products-api v0.0.0
+	sellers-api v0.0.0
+	shared v0.0.0
+)
+
+require (
+	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
+	github.com/x448/float16 v0.8.4 // indirect
+	golang.org/x/net v0.38.0 // indirect
+	golang.org/x/sys v0.31.0 // indirect
+	golang.org/x/text v0.23.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250324211829-b45e905df463 // indirect
+)
+
+replace shared => ../SHARED
+
+// Os servidores gRPC dos contextos só entram nos testes de conformidade
+replace (
+	brands-api => ../GRPC/CONTEXTOS/brands-api
+	categories-api => ../GRPC/CONTEXTOS/categories-api
+	images-api => ../GRPC/CONTEXTOS/images-api
+	products-api => ../GRPC/CONTEXTOS/products-api
+	sellers-api => ../GRPC/CONTEXTOS/sellers-api
+)
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"bff/clients"
+)
+
+type enrichFunc func(ctx context.Context, backend *clients.Backend, slug string) (*ProductResponse, error)
+
+// handler deriva todas as chamadas aos contextos de r.Context(): se o cliente
+// desconectar ou o budget acabar, as buscas em andamento são canceladas. A
+// política decide o status quando alguma entidade falhou e, no fail-fast,
+// também cancela as buscas na primeira falha.
+func handler(backend *clients.Backend, cfg config, enrich enrichFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), cfg.Budget)
+		defer cancel()
+		ctx, abort := cfg.Policy.Context(ctx)
+		defer abort()
+
+		slug := mux.Vars(r)["slug"]
+		product, err := enrich(ctx, backend, slug)
+		if err != nil {
+			http.Error(w, err.Error(), httpStatus(err))
+			return
+		}
+		product.sortErrors()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(cfg.Policy.Status(product))
+		json.NewEncoder(w).Encode(product)
+	}
+}
+
+// newRouter monta as rotas do BFF sobre backend; /multiplexado só existe
+// quando o transporte tem streams
+func newRouter(backend *clients.Backend, cfg config) *mux.Router {
+	r := mux.NewRouter()
+	r.HandleFunc("/sequencial/{slug}", handler(backend, cfg, EnrichProductSequential)).Methods("GET")
+	r.HandleFunc("/paralelo/{slug}", handler(backend, cfg, EnrichProductParallel)).Methods("GET")
+	r.HandleFunc("/lote/{slug}", handler(backend, cfg, EnrichProductBatch)).Methods("GET")
+	r.HandleFunc("/servidor/{slug}", handler(backend, cfg, EnrichProductServer)).Methods("GET")
+	r.HandleFunc("/exportar", exportHandler(backend, cfg.Budget)).Methods("GET")
+	r.HandleFunc("/schema/product-response.json", schemaHandler).Methods("GET")
+
+	// Mesma agregação do /paralelo, com as requisições simultâneas dividindo
+	// um stream por contexto
+	if backend.Streams != nil {
+		r.HandleFunc("/multiplexado/{slug}", handler(backend.Streams, cfg, EnrichProductParallel)).Methods("GET")
+	}
+
+	return r
+}
+
+func main() {
+	cfg, err := loadConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	backend, err := newBackend(cfg)
+	if err != nil {
+		log.Fatalf("Erro ao inicializar clientes %s: %v", cfg.Transport, err)
+	}
+	defer backend.Close()
+
+	log.Printf("Servidor BFF (%s) rodando na porta 8080, budget de %s por requisição, política %s", cfg.Transport, cfg.Budget, cfg.Policy.Mode)
+	http.ListenAndServe(":8080", newRouter(backend, cfg))
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	"bff/clients"
+)
+
+// Policy decide o status HTTP quando alguma entidade falhou. O corpo é
+// sempre o ProductResponse com partial e errors preenchidos.
+//
+//   - fail-fast: qualquer falha vira 502 e cancela as buscas ainda em
+//     andamento
+//   - partial: responde 200 com o que conseguiu buscar
+//   - required-fields: 502 só se falhou algum campo de Required
+type Policy struct {
+	Mode     string
+	Required []string
+}
+
+var responseFields = []string{"seller", "brand", "categories", "images"}
+
+// parsePolicy lê o modo e a lista de campos obrigatórios separados por
+// vírgula
+func parsePolicy(mode, required string) (Policy, error) {
+	p := Policy{Mode: mode}
+	switch mode {
+	case "fail-fast", "partial":
+	case "required-fields":
+		for _, field := range strings.Split(required, ",") {
+			field = strings.TrimSpace(field)
+			if !slices.Contains(responseFields, field) {
+				return p, fmt.Errorf("campo obrigatório desconhecido: %q (use %s)", field, strings.Join(responseFields, ", "))
+			}
+			p.Required = append(p.Required, field)
+		}
+	default:
+		return p, fmt.Errorf("política de falha desconhecida: %q (use fail-fast, partial ou required-fields)", mode)
+	}
+	return p, nil
+}
+
+type abortKey struct{}
+
+// errAborted substitui o erro das buscas canceladas pelo fail-fast
+var errAborted = errors.New("cancelada pela falha de outra entidade")
+
+// Context deriva o ctx das buscas de uma requisição. No fail-fast a resposta
+// vai ser 502 com a primeira falha, então ela cancela as outras buscas em vez
+// de esperar por elas; nas outras políticas ctx volta como está.
+func (p Policy) Context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if p.Mode != "fail-fast" {
+		return ctx, func() {}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return context.WithValue(ctx, abortKey{}, cancel), cancel
+}
+
+func (p Policy) Status(response *ProductResponse) int {
+	if !response.Partial {
+		return http.StatusOK
+	}
+	switch p.Mode {
+	case "fail-fast":
+		return http.StatusBadGateway
+	case "required-fields":
+		for _, e := range response.Errors {
+			if slices.Contains(p.Required, e.Field) {
+				return http.StatusBadGateway
+			}
+		}
+	}
+	return http.StatusOK
+}
+
+// httpStatus traduz a falha ao buscar o próprio produto, que impede qualquer
+// resposta: 404 e 400 vêm do cliente, 503 e 504 do contexto fora do ar ou do
+// budget esgotado, e o resto vira 502
+func httpStatus(err error) int {
+	switch clients.Code(err) {
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusBadGateway
+}
+
+// sortErrors ordena os erros na ordem dos campos da resposta e, dentro de
+// cada campo, por ID, já que no /paralelo eles chegam na ordem de término
+func (r *ProductResponse) sortErrors() {
+	slices.SortStableFunc(r.Errors, func(a, b FieldError) int {
+		return cmp.Or(
+			cmp.Compare(slices.Index(responseFields, a.Field), slices.Index(responseFields, b.Field)),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+// productResponseSchema é o JSON Schema do ProductResponse, o mesmo para
+// todos os transportes
+//
+//go:embed schema/product-response.schema.json
+var productResponseSchema []byte
+
+func schemaHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/schema+json")
+	w.Write(productResponseSchema)
+}
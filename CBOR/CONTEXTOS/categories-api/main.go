@@ -0,0 +1,26 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"shared/dataset"
+	"shared/httpapi"
+	"shared/negotiate"
+)
+
+// CBOR por padrão; o Accept pode pedir qualquer outro formato
+var formats = negotiate.Offer(negotiate.CBOR)
+
+func main() {
+	cfg, err := dataset.Load()
+	if err != nil {
+		log.Fatal(err)
+	}
+	r, err := httpapi.Categories(cfg.Size, formats)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	http.ListenAndServe(":8080", r)
+}
@@ -1,235 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.6
-// 	protoc        v6.30.2
-// source: proto/image/image.proto
-
-package imagepb
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type Image struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Image) Reset() {
-	*x = Image{}
-	mi := &file_proto_image_image_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Image) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Image) ProtoMessage() {}
-
-func (x *Image) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_image_image_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Image.ProtoReflect.Descriptor instead.
-func (*Image) Descriptor() ([]byte, []int) {
-	return file_proto_image_image_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Image) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Image) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
-
-type ImageId struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ImageId) Reset() {
-	*x = ImageId{}
-	mi := &file_proto_image_image_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ImageId) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ImageId) ProtoMessage() {}
-
-func (x *ImageId) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_image_image_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ImageId.ProtoReflect.Descriptor instead.
-func (*ImageId) Descriptor() ([]byte, []int) {
-	return file_proto_image_image_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *ImageId) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type ImageList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Images        []*Image               `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ImageList) Reset() {
-	*x = ImageList{}
-	mi := &file_proto_image_image_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ImageList) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ImageList) ProtoMessage() {}
-
-func (x *ImageList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_image_image_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ImageList.ProtoReflect.Descriptor instead.
-func (*ImageList) Descriptor() ([]byte, []int) {
-	return file_proto_image_image_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *ImageList) GetImages() []*Image {
-	if x != nil {
-		return x.Images
-	}
-	return nil
-}
-
-var File_proto_image_image_proto protoreflect.FileDescriptor
-
-const file_proto_image_image_proto_rawDesc = "" +
-	"\n" +
-	"\x17proto/image/image.proto\x12\x05proto\x1a\x1bgoogle/protobuf/empty.proto\")\n" +
-	"\x05Image\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
-	"\x03url\x18\x02 \x01(\tR\x03url\"\x19\n" +
-	"\aImageId\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"1\n" +
-	"\tImageList\x12$\n" +
-	"\x06images\x18\x01 \x03(\v2\f.proto.ImageR\x06images2v\n" +
-	"\fImageService\x128\n" +
-	"\fGetAllImages\x12\x16.google.protobuf.Empty\x1a\x10.proto.ImageList\x12,\n" +
-	"\fGetImageByID\x12\x0e.proto.ImageId\x1a\f.proto.ImageB\x17Z\x15./proto/image;imagepbb\x06proto3"
-
-var (
-	file_proto_image_image_proto_rawDescOnce sync.Once
-	file_proto_image_image_proto_rawDescData []byte
-)
-
-func file_proto_image_image_proto_rawDescGZIP() []byte {
-	file_proto_image_image_proto_rawDescOnce.Do(func() {
-		file_proto_image_image_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_image_image_proto_rawDesc), len(file_proto_image_image_proto_rawDesc)))
-	})
-	return file_proto_image_image_proto_rawDescData
-}
-
-var file_proto_image_image_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
-var file_proto_image_image_proto_goTypes = []any{
-	(*Image)(nil),         // 0: proto.Image
-	(*ImageId)(nil),       // 1: proto.ImageId
-	(*ImageList)(nil),     // 2: proto.ImageList
-	(*emptypb.Empty)(nil), // 3: google.protobuf.Empty
-}
-var file_proto_image_image_proto_depIdxs = []int32{
-	0, // 0: proto.ImageList.images:type_name -> proto.Image
-	3, // 1: proto.ImageService.GetAllImages:input_type -> google.protobuf.Empty
-	1, // 2: proto.ImageService.GetImageByID:input_type -> proto.ImageId
-	2, // 3: proto.ImageService.GetAllImages:output_type -> proto.ImageList
-	0, // 4: proto.ImageService.GetImageByID:output_type -> proto.Image
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
-}
-
-func init() { file_proto_image_image_proto_init() }
-func file_proto_image_image_proto_init() {
-	if File_proto_image_image_proto != nil {
-		return
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_image_image_proto_rawDesc), len(file_proto_image_image_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   3,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_proto_image_image_proto_goTypes,
-		DependencyIndexes: file_proto_image_image_proto_depIdxs,
-		MessageInfos:      file_proto_image_image_proto_msgTypes,
-	}.Build()
-	File_proto_image_image_proto = out.File
-	file_proto_image_image_proto_goTypes = nil
-	file_proto_image_image_proto_depIdxs = nil
-}
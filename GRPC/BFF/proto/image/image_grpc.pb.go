@@ -1,160 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.5.1
-// - protoc             v6.30.2
-// source: proto/image/image.proto
-
-package imagepb
-
-import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
-
-const (
-	ImageService_GetAllImages_FullMethodName = "/proto.ImageService/GetAllImages"
-	ImageService_GetImageByID_FullMethodName = "/proto.ImageService/GetImageByID"
-)
-
-// ImageServiceClient is the client API for ImageService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type ImageServiceClient interface {
-	GetAllImages(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ImageList, error)
-	GetImageByID(ctx context.Context, in *ImageId, opts ...grpc.CallOption) (*Image, error)
-}
-
-type imageServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewImageServiceClient(cc grpc.ClientConnInterface) ImageServiceClient {
-	return &imageServiceClient{cc}
-}
-
-func (c *imageServiceClient) GetAllImages(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ImageList, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ImageList)
-	err := c.cc.Invoke(ctx, ImageService_GetAllImages_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *imageServiceClient) GetImageByID(ctx context.Context, in *ImageId, opts ...grpc.CallOption) (*Image, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Image)
-	err := c.cc.Invoke(ctx, ImageService_GetImageByID_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// ImageServiceServer is the server API for ImageService service.
-// All implementations must embed UnimplementedImageServiceServer
-// for forward compatibility.
-type ImageServiceServer interface {
-	GetAllImages(context.Context, *emptypb.Empty) (*ImageList, error)
-	GetImageByID(context.Context, *ImageId) (*Image, error)
-	mustEmbedUnimplementedImageServiceServer()
-}
-
-// UnimplementedImageServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedImageServiceServer struct{}
-
-func (UnimplementedImageServiceServer) GetAllImages(context.Context, *emptypb.Empty) (*ImageList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllImages not implemented")
-}
-func (UnimplementedImageServiceServer) GetImageByID(context.Context, *ImageId) (*Image, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetImageByID not implemented")
-}
-func (UnimplementedImageServiceServer) mustEmbedUnimplementedImageServiceServer() {}
-func (UnimplementedImageServiceServer) testEmbeddedByValue()                      {}
-
-// UnsafeImageServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ImageServiceServer will
-// result in compilation errors.
-type UnsafeImageServiceServer interface {
-	mustEmbedUnimplementedImageServiceServer()
-}
-
-func RegisterImageServiceServer(s grpc.ServiceRegistrar, srv ImageServiceServer) {
-	// If the following call pancis, it indicates UnimplementedImageServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&ImageService_ServiceDesc, srv)
-}
-
-func _ImageService_GetAllImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ImageServiceServer).GetAllImages(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ImageService_GetAllImages_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImageServiceServer).GetAllImages(ctx, req.(*emptypb.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ImageService_GetImageByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImageId)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ImageServiceServer).GetImageByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ImageService_GetImageByID_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImageServiceServer).GetImageByID(ctx, req.(*ImageId))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// ImageService_ServiceDesc is the grpc.ServiceDesc for ImageService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ImageService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.ImageService",
-	HandlerType: (*ImageServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetAllImages",
-			Handler:    _ImageService_GetAllImages_Handler,
-		},
-		{
-			MethodName: "GetImageByID",
-			Handler:    _ImageService_GetImageByID_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/image/image.proto",
-}
@@ -1,357 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.6
-// 	protoc        v6.30.2
-// source: proto/product/product.proto
-
-package productpb
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type Product struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price         *Price                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
-	SellerId      int32                  `protobuf:"varint,6,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
-	BrandId       int32                  `protobuf:"varint,7,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
-	Categories    []int32                `protobuf:"varint,8,rep,packed,name=categories,proto3" json:"categories,omitempty"`
-	Images        []int32                `protobuf:"varint,9,rep,packed,name=images,proto3" json:"images,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Product) Reset() {
-	*x = Product{}
-	mi := &file_proto_product_product_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Product) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Product) ProtoMessage() {}
-
-func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_product_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Product.ProtoReflect.Descriptor instead.
-func (*Product) Descriptor() ([]byte, []int) {
-	return file_proto_product_product_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Product) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Product) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Product) GetSlug() string {
-	if x != nil {
-		return x.Slug
-	}
-	return ""
-}
-
-func (x *Product) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Product) GetPrice() *Price {
-	if x != nil {
-		return x.Price
-	}
-	return nil
-}
-
-func (x *Product) GetSellerId() int32 {
-	if x != nil {
-		return x.SellerId
-	}
-	return 0
-}
-
-func (x *Product) GetBrandId() int32 {
-	if x != nil {
-		return x.BrandId
-	}
-	return 0
-}
-
-func (x *Product) GetCategories() []int32 {
-	if x != nil {
-		return x.Categories
-	}
-	return nil
-}
-
-func (x *Product) GetImages() []int32 {
-	if x != nil {
-		return x.Images
-	}
-	return nil
-}
-
-type Slug struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Slug) Reset() {
-	*x = Slug{}
-	mi := &file_proto_product_product_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Slug) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Slug) ProtoMessage() {}
-
-func (x *Slug) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_product_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Slug.ProtoReflect.Descriptor instead.
-func (*Slug) Descriptor() ([]byte, []int) {
-	return file_proto_product_product_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *Slug) GetSlug() string {
-	if x != nil {
-		return x.Slug
-	}
-	return ""
-}
-
-type ProductList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ProductList) Reset() {
-	*x = ProductList{}
-	mi := &file_proto_product_product_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ProductList) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ProductList) ProtoMessage() {}
-
-func (x *ProductList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_product_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ProductList.ProtoReflect.Descriptor instead.
-func (*ProductList) Descriptor() ([]byte, []int) {
-	return file_proto_product_product_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *ProductList) GetProducts() []*Product {
-	if x != nil {
-		return x.Products
-	}
-	return nil
-}
-
-type Price struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Original      float32                `protobuf:"fixed32,1,opt,name=original,proto3" json:"original,omitempty"`
-	SpecialPrice  float32                `protobuf:"fixed32,2,opt,name=special_price,json=specialPrice,proto3" json:"special_price,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Price) Reset() {
-	*x = Price{}
-	mi := &file_proto_product_product_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Price) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Price) ProtoMessage() {}
-
-func (x *Price) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_product_proto_msgTypes[3]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Price.ProtoReflect.Descriptor instead.
-func (*Price) Descriptor() ([]byte, []int) {
-	return file_proto_product_product_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *Price) GetOriginal() float32 {
-	if x != nil {
-		return x.Original
-	}
-	return 0
-}
-
-func (x *Price) GetSpecialPrice() float32 {
-	if x != nil {
-		return x.SpecialPrice
-	}
-	return 0
-}
-
-var File_proto_product_product_proto protoreflect.FileDescriptor
-
-const file_proto_product_product_proto_rawDesc = "" +
-	"\n" +
-	"\x1bproto/product/product.proto\x12\x05proto\x1a\x1bgoogle/protobuf/empty.proto\"\xf7\x01\n" +
-	"\aProduct\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04slug\x18\x03 \x01(\tR\x04slug\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\"\n" +
-	"\x05price\x18\x05 \x01(\v2\f.proto.PriceR\x05price\x12\x1b\n" +
-	"\tseller_id\x18\x06 \x01(\x05R\bsellerId\x12\x19\n" +
-	"\bbrand_id\x18\a \x01(\x05R\abrandId\x12\x1e\n" +
-	"\n" +
-	"categories\x18\b \x03(\x05R\n" +
-	"categories\x12\x16\n" +
-	"\x06images\x18\t \x03(\x05R\x06images\"\x1a\n" +
-	"\x04Slug\x12\x12\n" +
-	"\x04slug\x18\x01 \x01(\tR\x04slug\"9\n" +
-	"\vProductList\x12*\n" +
-	"\bproducts\x18\x01 \x03(\v2\x0e.proto.ProductR\bproducts\"H\n" +
-	"\x05Price\x12\x1a\n" +
-	"\boriginal\x18\x01 \x01(\x02R\boriginal\x12#\n" +
-	"\rspecial_price\x18\x02 \x01(\x02R\fspecialPrice2\x7f\n" +
-	"\x0eProductService\x12<\n" +
-	"\x0eGetAllProducts\x12\x16.google.protobuf.Empty\x1a\x12.proto.ProductList\x12/\n" +
-	"\x10GetProductBySlug\x12\v.proto.Slug\x1a\x0e.proto.ProductB\x1bZ\x19./proto/product;productpbb\x06proto3"
-
-var (
-	file_proto_product_product_proto_rawDescOnce sync.Once
-	file_proto_product_product_proto_rawDescData []byte
-)
-
-func file_proto_product_product_proto_rawDescGZIP() []byte {
-	file_proto_product_product_proto_rawDescOnce.Do(func() {
-		file_proto_product_product_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_product_product_proto_rawDesc), len(file_proto_product_product_proto_rawDesc)))
-	})
-	return file_proto_product_product_proto_rawDescData
-}
-
-var file_proto_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_proto_product_product_proto_goTypes = []any{
-	(*Product)(nil),       // 0: proto.Product
-	(*Slug)(nil),          // 1: proto.Slug
-	(*ProductList)(nil),   // 2: proto.ProductList
-	(*Price)(nil),         // 3: proto.Price
-	(*emptypb.Empty)(nil), // 4: google.protobuf.Empty
-}
-var file_proto_product_product_proto_depIdxs = []int32{
-	3, // 0: proto.Product.price:type_name -> proto.Price
-	0, // 1: proto.ProductList.products:type_name -> proto.Product
-	4, // 2: proto.ProductService.GetAllProducts:input_type -> google.protobuf.Empty
-	1, // 3: proto.ProductService.GetProductBySlug:input_type -> proto.Slug
-	2, // 4: proto.ProductService.GetAllProducts:output_type -> proto.ProductList
-	0, // 5: proto.ProductService.GetProductBySlug:output_type -> proto.Product
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
-}
-
-func init() { file_proto_product_product_proto_init() }
-func file_proto_product_product_proto_init() {
-	if File_proto_product_product_proto != nil {
-		return
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_product_product_proto_rawDesc), len(file_proto_product_product_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_proto_product_product_proto_goTypes,
-		DependencyIndexes: file_proto_product_product_proto_depIdxs,
-		MessageInfos:      file_proto_product_product_proto_msgTypes,
-	}.Build()
-	File_proto_product_product_proto = out.File
-	file_proto_product_product_proto_goTypes = nil
-	file_proto_product_product_proto_depIdxs = nil
-}
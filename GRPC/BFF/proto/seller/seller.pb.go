@@ -1,236 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.6
-// 	protoc        v6.30.2
-// source: proto/seller/seller.proto
-
-package sellerpb
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type Seller struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Seller) Reset() {
-	*x = Seller{}
-	mi := &file_proto_seller_seller_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Seller) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Seller) ProtoMessage() {}
-
-func (x *Seller) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_seller_seller_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Seller.ProtoReflect.Descriptor instead.
-func (*Seller) Descriptor() ([]byte, []int) {
-	return file_proto_seller_seller_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Seller) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Seller) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type SellerId struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SellerId) Reset() {
-	*x = SellerId{}
-	mi := &file_proto_seller_seller_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SellerId) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SellerId) ProtoMessage() {}
-
-func (x *SellerId) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_seller_seller_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SellerId.ProtoReflect.Descriptor instead.
-func (*SellerId) Descriptor() ([]byte, []int) {
-	return file_proto_seller_seller_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *SellerId) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type SellerList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sellers       []*Seller              `protobuf:"bytes,1,rep,name=sellers,proto3" json:"sellers,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SellerList) Reset() {
-	*x = SellerList{}
-	mi := &file_proto_seller_seller_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SellerList) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SellerList) ProtoMessage() {}
-
-func (x *SellerList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_seller_seller_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SellerList.ProtoReflect.Descriptor instead.
-func (*SellerList) Descriptor() ([]byte, []int) {
-	return file_proto_seller_seller_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *SellerList) GetSellers() []*Seller {
-	if x != nil {
-		return x.Sellers
-	}
-	return nil
-}
-
-var File_proto_seller_seller_proto protoreflect.FileDescriptor
-
-const file_proto_seller_seller_proto_rawDesc = "" +
-	"\n" +
-	"\x19proto/seller/seller.proto\x12\x05proto\x1a\x1bgoogle/protobuf/empty.proto\",\n" +
-	"\x06Seller\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\x1a\n" +
-	"\bSellerId\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"5\n" +
-	"\n" +
-	"SellerList\x12'\n" +
-	"\asellers\x18\x01 \x03(\v2\r.proto.SellerR\asellers2|\n" +
-	"\rSellerService\x12:\n" +
-	"\rGetAllSellers\x12\x16.google.protobuf.Empty\x1a\x11.proto.SellerList\x12/\n" +
-	"\rGetSellerByID\x12\x0f.proto.SellerId\x1a\r.proto.SellerB\x19Z\x17./proto/seller;sellerpbb\x06proto3"
-
-var (
-	file_proto_seller_seller_proto_rawDescOnce sync.Once
-	file_proto_seller_seller_proto_rawDescData []byte
-)
-
-func file_proto_seller_seller_proto_rawDescGZIP() []byte {
-	file_proto_seller_seller_proto_rawDescOnce.Do(func() {
-		file_proto_seller_seller_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_seller_seller_proto_rawDesc), len(file_proto_seller_seller_proto_rawDesc)))
-	})
-	return file_proto_seller_seller_proto_rawDescData
-}
-
-var file_proto_seller_seller_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
-var file_proto_seller_seller_proto_goTypes = []any{
-	(*Seller)(nil),        // 0: proto.Seller
-	(*SellerId)(nil),      // 1: proto.SellerId
-	(*SellerList)(nil),    // 2: proto.SellerList
-	(*emptypb.Empty)(nil), // 3: google.protobuf.Empty
-}
-var file_proto_seller_seller_proto_depIdxs = []int32{
-	0, // 0: proto.SellerList.sellers:type_name -> proto.Seller
-	3, // 1: proto.SellerService.GetAllSellers:input_type -> google.protobuf.Empty
-	1, // 2: proto.SellerService.GetSellerByID:input_type -> proto.SellerId
-	2, // 3: proto.SellerService.GetAllSellers:output_type -> proto.SellerList
-	0, // 4: proto.SellerService.GetSellerByID:output_type -> proto.Seller
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
-}
-
-func init() { file_proto_seller_seller_proto_init() }
-func file_proto_seller_seller_proto_init() {
-	if File_proto_seller_seller_proto != nil {
-		return
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_seller_seller_proto_rawDesc), len(file_proto_seller_seller_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   3,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_proto_seller_seller_proto_goTypes,
-		DependencyIndexes: file_proto_seller_seller_proto_depIdxs,
-		MessageInfos:      file_proto_seller_seller_proto_msgTypes,
-	}.Build()
-	File_proto_seller_seller_proto = out.File
-	file_proto_seller_seller_proto_goTypes = nil
-	file_proto_seller_seller_proto_depIdxs = nil
-}
@@ -4,8 +4,9 @@ import (
 	"log"
 	"net"
 
-	pb "brands-api/proto"
 	"brands-api/server"
+	"shared/dataset"
+	pb "shared/proto/brand"
 
 	"google.golang.org/grpc"
 )
@@ -16,8 +17,13 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
+	cfg, err := dataset.Load()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	s := grpc.NewServer()
-	pb.RegisterBrandServiceServer(s, &server.BrandServer{})
+	pb.RegisterBrandServiceServer(s, server.NewBrandServer(cfg.Size))
 
 	log.Println("Brand gRPC server running on port 8080")
 	if err := s.Serve(lis); err != nil {
@@ -2,54 +2,62 @@ package server
 
 import (
 	"context"
-	"errors"
-	"fmt"
 
-	pb "brands-api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"shared/dataset"
+	"shared/grpcserver"
+	pb "shared/proto/brand"
+	"shared/repository"
 )
 
 type BrandServer struct {
 	pb.UnimplementedBrandServiceServer
+	brands *repository.Repository[*pb.Brand]
+	writes *grpcserver.Writes[*pb.Brand]
 }
 
-var brands = []*pb.Brand{}
-
-func init() {
-	descriptions := []string{
-		"Marca premium com presença global.",
-		"Referência em sustentabilidade.",
-		"Foco em design minimalista e funcional.",
-		"Marca líder em tecnologia de consumo.",
-		"Conhecida por produtos acessíveis e duráveis.",
-	}
-
-	countries := []string{
-		"Brasil",
-		"Estados Unidos",
-		"Alemanha",
-		"Japão",
-	}
+func NewBrandServer(size int) *BrandServer {
+	brands := pb.ListFromDomain(dataset.Brands(size)).Brands
+	repo := repository.New(brands, func(b *pb.Brand) int { return int(b.Id) })
+	return &BrandServer{brands: repo, writes: newWrites(repo)}
+}
 
-	for i := 1; i <= 100; i++ {
-		brands = append(brands, &pb.Brand{
-			Id:          int32(i),
-			Name:        fmt.Sprintf("Brand %d", i),
-			Description: descriptions[i%len(descriptions)],
-			Country:     countries[i%len(countries)],
-			Active:      i%2 == 0,
-		})
-	}
+func (s *BrandServer) GetAllBrands(ctx context.Context, _ *emptypb.Empty) (*pb.BrandList, error) {
+	return &pb.BrandList{Brands: s.brands.All()}, nil
 }
 
-func (s *BrandServer) GetAllBrands(ctx context.Context, _ *pb.Empty) (*pb.BrandList, error) {
-	return &pb.BrandList{Brands: brands}, nil
+// StreamBrands envia o catálogo item a item; o cliente recebe os primeiros
+// antes do último ser enviado
+func (s *BrandServer) StreamBrands(_ *emptypb.Empty, stream grpc.ServerStreamingServer[pb.Brand]) error {
+	for _, item := range s.brands.All() {
+		if err := stream.Send(item); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *BrandServer) GetBrandByID(ctx context.Context, req *pb.BrandRequest) (*pb.Brand, error) {
-	for _, b := range brands {
-		if b.Id == req.Id {
-			return b, nil
-		}
+	if req.Id < 1 {
+		return nil, errorDomain.InvalidID(req.Id)
+	}
+	if b, ok := s.brands.ByID(int(req.Id)); ok {
+		return b, nil
 	}
-	return nil, errors.New("marca não encontrada")
+	return nil, brandNotFound(req.Id)
+}
+
+// EnrichStream responde cada pedido com a busca da chamada unária; um ID
+// inexistente vira erro na resposta e não encerra o stream
+func (s *BrandServer) EnrichStream(stream grpc.BidiStreamingServer[pb.BrandStreamRequest, pb.BrandStreamResponse]) error {
+	return grpcserver.EnrichStream(stream, (*pb.BrandStreamRequest).GetTag, (*pb.BrandStreamRequest).GetId,
+		func(ctx context.Context, id int32) (*pb.Brand, error) {
+			return s.GetBrandByID(ctx, &pb.BrandRequest{Id: id})
+		},
+		func(tag uint64, brand *pb.Brand, st *status.Status) *pb.BrandStreamResponse {
+			return &pb.BrandStreamResponse{Tag: tag, Brand: brand, Error: st.Message(), Code: int32(st.Code())}
+		})
 }
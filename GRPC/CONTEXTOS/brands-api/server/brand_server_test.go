@@ -0,0 +1,208 @@
+package server
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"shared/dataset"
+	"shared/grpcserver/grpctest"
+	pb "shared/proto/brand"
+)
+
+const catalogSize = 20
+
+// newClient registra um BrandServer em um listener em memória e devolve um
+// cliente ligado a ele
+func newClient(t *testing.T) pb.BrandServiceClient {
+	t.Helper()
+	conn := grpctest.Dial(t, func(s *grpc.Server) { pb.RegisterBrandServiceServer(s, NewBrandServer(catalogSize)) })
+	return pb.NewBrandServiceClient(conn)
+}
+
+// checkStatus confere o código e o motivo do ErrorInfo de err
+func checkStatus(t *testing.T, err error, code codes.Code, reason string) {
+	t.Helper()
+	grpctest.CheckStatus(t, err, code, errorDomain, reason)
+}
+
+func TestGetAllBrands(t *testing.T) {
+	client := newClient(t)
+
+	list, err := client.GetAllBrands(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := dataset.Brands(catalogSize)
+	if len(list.Brands) != len(want) {
+		t.Fatalf("%d marcas, esperado %d", len(list.Brands), len(want))
+	}
+	for i, b := range list.Brands {
+		if int(b.Id) != want[i].ID || b.Name != want[i].Name {
+			t.Errorf("posição %d: %d %q, esperado %d %q", i, b.Id, b.Name, want[i].ID, want[i].Name)
+		}
+	}
+}
+
+func TestGetBrandByID(t *testing.T) {
+	client := newClient(t)
+
+	for _, want := range dataset.Brands(catalogSize) {
+		b, err := client.GetBrandByID(context.Background(), &pb.BrandRequest{Id: int32(want.ID)})
+		if err != nil {
+			t.Fatalf("%d: %v", want.ID, err)
+		}
+		if b.Name != want.Name || b.Description != want.Description || b.Country != want.Country || b.Active != want.Active {
+			t.Errorf("%d: %v, esperado %+v", want.ID, b, want)
+		}
+	}
+}
+
+func TestGetBrandByIDNotFound(t *testing.T) {
+	client := newClient(t)
+
+	_, err := client.GetBrandByID(context.Background(), &pb.BrandRequest{Id: catalogSize + 1})
+	checkStatus(t, err, codes.NotFound, "BRAND_NOT_FOUND")
+}
+
+func TestGetBrandByIDInvalid(t *testing.T) {
+	client := newClient(t)
+
+	for _, id := range []int32{0, -1} {
+		_, err := client.GetBrandByID(context.Background(), &pb.BrandRequest{Id: id})
+		checkStatus(t, err, codes.InvalidArgument, "INVALID_ID")
+	}
+}
+
+// As regras das escritas são testadas em shared/grpcserver; aqui fica a
+// ligação das RPCs com o repositório e os motivos dos erros do contexto
+func TestWrites(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateBrand(ctx, &pb.Brand{Name: "Marca Nova", Description: "Descrição", Country: "Brasil", Active: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := client.GetBrandByID(ctx, &pb.BrandRequest{Id: created.Id}); err != nil || !proto.Equal(got, created) {
+		t.Errorf("%v %v, esperado %v", got, err, created)
+	}
+	_, err = client.CreateBrand(ctx, &pb.Brand{Name: "Sem país"})
+	checkStatus(t, err, codes.InvalidArgument, "INVALID_BRAND")
+
+	want := proto.Clone(created).(*pb.Brand)
+	want.Name = "Alterada"
+	if updated, err := client.UpdateBrand(ctx, want); err != nil || !proto.Equal(updated, want) {
+		t.Errorf("%v %v, esperado %v", updated, err, want)
+	}
+	want.Name = "Alterada de novo"
+	req := &pb.PatchBrandRequest{Brand: &pb.Brand{Id: created.Id, Name: want.Name}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}}}
+	if patched, err := client.PatchBrand(ctx, req); err != nil || !proto.Equal(patched, want) {
+		t.Errorf("%v %v, esperado %v", patched, err, want)
+	}
+
+	if _, err := client.DeleteBrand(ctx, &pb.BrandRequest{Id: created.Id}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.DeleteBrand(ctx, &pb.BrandRequest{Id: created.Id})
+	checkStatus(t, err, codes.NotFound, "BRAND_NOT_FOUND")
+}
+
+// O EnrichStream responde na ordem dos pedidos, com a tag de cada um; as
+// falhas trazem o código da chamada unária e não encerram o stream
+func TestEnrichStream(t *testing.T) {
+	stream, err := newClient(t).EnrichStream(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		id   int32
+		code codes.Code
+	}{{3, codes.OK}, {catalogSize + 1, codes.NotFound}, {1, codes.OK}, {0, codes.InvalidArgument}}
+	for i, c := range cases {
+		if err := stream.Send(&pb.BrandStreamRequest{Tag: uint64(i), Id: c.id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stream.CloseSend()
+
+	for i, c := range cases {
+		res, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Tag != uint64(i) || codes.Code(res.Code) != c.code || (res.Error == "") != (c.code == codes.OK) {
+			t.Errorf("%d: tag %d, código %v %q; esperado tag %d, código %v", c.id, res.Tag, codes.Code(res.Code), res.Error, i, c.code)
+		}
+		if c.code == codes.OK && res.GetBrand().GetId() != c.id {
+			t.Errorf("%d: %v", c.id, res.GetBrand())
+		}
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("%v, esperado io.EOF", err)
+	}
+}
+
+// StreamBrands envia o catálogo inteiro, na ordem de GetAllBrands
+func TestStreamBrands(t *testing.T) {
+	stream, err := newClient(t).StreamBrands(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []*pb.Brand
+	for {
+		item, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+	}
+
+	want := dataset.Brands(catalogSize)
+	if len(got) != len(want) {
+		t.Fatalf("%d marcas, esperado %d", len(got), len(want))
+	}
+	for i, item := range got {
+		if !proto.Equal(item, pb.FromDomain(want[i])) {
+			t.Errorf("posição %d: %v, esperado %+v", i, item, want[i])
+		}
+	}
+}
+
+// Cancelar o cliente no meio do stream faz o servidor parar de enviar; o
+// catálogo é grande para não caber nos buffers da conexão
+func TestStreamBrandsCanceled(t *testing.T) {
+	const size = 100_000
+	results := make(chan grpctest.StreamResult, 1)
+	conn := grpctest.Dial(t, func(s *grpc.Server) { pb.RegisterBrandServiceServer(s, NewBrandServer(size)) }, grpctest.RecordStreams(results))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := pb.NewBrandServiceClient(conn).StreamBrands(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	select {
+	case r := <-results:
+		if r.Err == nil || r.Sent >= size {
+			t.Errorf("servidor enviou %d de %d e terminou com %v, esperado erro antes do fim", r.Sent, size, r.Err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("servidor continua enviando depois do cancelamento")
+	}
+}
@@ -0,0 +1,14 @@
+package server
+
+import "shared/grpcserver"
+
+// errorDomain identifica o serviço no ErrorInfo dos erros
+const errorDomain grpcserver.Domain = "brands-api"
+
+func brandNotFound(id int32) error {
+	return errorDomain.NotFound("BRAND_NOT_FOUND", "marca não encontrada", id)
+}
+
+func invalidBrand(id int32, err error) error {
+	return errorDomain.Invalid("INVALID_BRAND", "marca inválida", id, err)
+}
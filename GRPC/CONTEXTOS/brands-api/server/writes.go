@@ -0,0 +1,41 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"shared/grpcserver"
+	pb "shared/proto/brand"
+	"shared/repository"
+)
+
+// newWrites liga as escritas ao repositório de marcas
+func newWrites(brands *repository.Repository[*pb.Brand]) *grpcserver.Writes[*pb.Brand] {
+	return &grpcserver.Writes[*pb.Brand]{
+		Repo:     brands,
+		Errors:   errorDomain,
+		Validate: func(b *pb.Brand) error { return b.ToDomain().Validate() },
+		Invalid:  invalidBrand,
+		NotFound: brandNotFound,
+	}
+}
+
+func (s *BrandServer) CreateBrand(ctx context.Context, req *pb.Brand) (*pb.Brand, error) {
+	return s.writes.Create(req)
+}
+
+func (s *BrandServer) UpdateBrand(ctx context.Context, req *pb.Brand) (*pb.Brand, error) {
+	return s.writes.Update(req.Id, req)
+}
+
+func (s *BrandServer) PatchBrand(ctx context.Context, req *pb.PatchBrandRequest) (*pb.Brand, error) {
+	return s.writes.Patch(req.GetBrand().GetId(), req.GetBrand(), req.GetUpdateMask().GetPaths())
+}
+
+func (s *BrandServer) DeleteBrand(ctx context.Context, req *pb.BrandRequest) (*emptypb.Empty, error) {
+	if err := s.writes.Delete(req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -4,8 +4,9 @@ import (
 	"log"
 	"net"
 
-	pb "categories-api/proto"
 	"categories-api/server"
+	"shared/dataset"
+	pb "shared/proto/category"
 
 	"google.golang.org/grpc"
 )
@@ -16,8 +17,13 @@ func main() {
 		log.Fatalf("Erro ao escutar: %v", err)
 	}
 
+	cfg, err := dataset.Load()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	s := grpc.NewServer()
-	pb.RegisterCategoryServiceServer(s, server.NewCategoryServer())
+	pb.RegisterCategoryServiceServer(s, server.NewCategoryServer(cfg.Size))
 
 	log.Println("Servidor gRPC de categorias rodando na porta 8080")
 	if err := s.Serve(lis); err != nil {
@@ -1,274 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.6
-// 	protoc        v6.30.2
-// source: proto/category.proto
-
-package categorypb
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type Category struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Category) Reset() {
-	*x = Category{}
-	mi := &file_proto_category_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Category) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Category) ProtoMessage() {}
-
-func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_category_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Category.ProtoReflect.Descriptor instead.
-func (*Category) Descriptor() ([]byte, []int) {
-	return file_proto_category_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Category) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Category) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type Empty struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Empty) Reset() {
-	*x = Empty{}
-	mi := &file_proto_category_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Empty) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Empty) ProtoMessage() {}
-
-func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_category_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_proto_category_proto_rawDescGZIP(), []int{1}
-}
-
-type CategoryId struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CategoryId) Reset() {
-	*x = CategoryId{}
-	mi := &file_proto_category_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CategoryId) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CategoryId) ProtoMessage() {}
-
-func (x *CategoryId) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_category_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CategoryId.ProtoReflect.Descriptor instead.
-func (*CategoryId) Descriptor() ([]byte, []int) {
-	return file_proto_category_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *CategoryId) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type CategoryList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CategoryList) Reset() {
-	*x = CategoryList{}
-	mi := &file_proto_category_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CategoryList) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CategoryList) ProtoMessage() {}
-
-func (x *CategoryList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_category_proto_msgTypes[3]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CategoryList.ProtoReflect.Descriptor instead.
-func (*CategoryList) Descriptor() ([]byte, []int) {
-	return file_proto_category_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *CategoryList) GetCategories() []*Category {
-	if x != nil {
-		return x.Categories
-	}
-	return nil
-}
-
-var File_proto_category_proto protoreflect.FileDescriptor
-
-const file_proto_category_proto_rawDesc = "" +
-	"\n" +
-	"\x14proto/category.proto\x12\x05proto\".\n" +
-	"\bCategory\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\a\n" +
-	"\x05Empty\"\x1c\n" +
-	"\n" +
-	"CategoryId\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"?\n" +
-	"\fCategoryList\x12/\n" +
-	"\n" +
-	"categories\x18\x01 \x03(\v2\x0f.proto.CategoryR\n" +
-	"categories2\x7f\n" +
-	"\x0fCategoryService\x125\n" +
-	"\x10GetAllCategories\x12\f.proto.Empty\x1a\x13.proto.CategoryList\x125\n" +
-	"\x0fGetCategoryByID\x12\x11.proto.CategoryId\x1a\x0f.proto.CategoryB\x14Z\x12./proto;categorypbb\x06proto3"
-
-var (
-	file_proto_category_proto_rawDescOnce sync.Once
-	file_proto_category_proto_rawDescData []byte
-)
-
-func file_proto_category_proto_rawDescGZIP() []byte {
-	file_proto_category_proto_rawDescOnce.Do(func() {
-		file_proto_category_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_category_proto_rawDesc), len(file_proto_category_proto_rawDesc)))
-	})
-	return file_proto_category_proto_rawDescData
-}
-
-var file_proto_category_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_proto_category_proto_goTypes = []any{
-	(*Category)(nil),     // 0: proto.Category
-	(*Empty)(nil),        // 1: proto.Empty
-	(*CategoryId)(nil),   // 2: proto.CategoryId
-	(*CategoryList)(nil), // 3: proto.CategoryList
-}
-var file_proto_category_proto_depIdxs = []int32{
-	0, // 0: proto.CategoryList.categories:type_name -> proto.Category
-	1, // 1: proto.CategoryService.GetAllCategories:input_type -> proto.Empty
-	2, // 2: proto.CategoryService.GetCategoryByID:input_type -> proto.CategoryId
-	3, // 3: proto.CategoryService.GetAllCategories:output_type -> proto.CategoryList
-	0, // 4: proto.CategoryService.GetCategoryByID:output_type -> proto.Category
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
-}
-
-func init() { file_proto_category_proto_init() }
-func file_proto_category_proto_init() {
-	if File_proto_category_proto != nil {
-		return
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_category_proto_rawDesc), len(file_proto_category_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_proto_category_proto_goTypes,
-		DependencyIndexes: file_proto_category_proto_depIdxs,
-		MessageInfos:      file_proto_category_proto_msgTypes,
-	}.Build()
-	File_proto_category_proto = out.File
-	file_proto_category_proto_goTypes = nil
-	file_proto_category_proto_depIdxs = nil
-}
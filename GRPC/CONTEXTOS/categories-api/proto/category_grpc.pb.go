@@ -1,159 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.5.1
-// - protoc             v6.30.2
-// source: proto/category.proto
-
-package categorypb
-
-import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
-
-const (
-	CategoryService_GetAllCategories_FullMethodName = "/proto.CategoryService/GetAllCategories"
-	CategoryService_GetCategoryByID_FullMethodName  = "/proto.CategoryService/GetCategoryByID"
-)
-
-// CategoryServiceClient is the client API for CategoryService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type CategoryServiceClient interface {
-	GetAllCategories(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CategoryList, error)
-	GetCategoryByID(ctx context.Context, in *CategoryId, opts ...grpc.CallOption) (*Category, error)
-}
-
-type categoryServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewCategoryServiceClient(cc grpc.ClientConnInterface) CategoryServiceClient {
-	return &categoryServiceClient{cc}
-}
-
-func (c *categoryServiceClient) GetAllCategories(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CategoryList, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CategoryList)
-	err := c.cc.Invoke(ctx, CategoryService_GetAllCategories_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *categoryServiceClient) GetCategoryByID(ctx context.Context, in *CategoryId, opts ...grpc.CallOption) (*Category, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Category)
-	err := c.cc.Invoke(ctx, CategoryService_GetCategoryByID_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// CategoryServiceServer is the server API for CategoryService service.
-// All implementations must embed UnimplementedCategoryServiceServer
-// for forward compatibility.
-type CategoryServiceServer interface {
-	GetAllCategories(context.Context, *Empty) (*CategoryList, error)
-	GetCategoryByID(context.Context, *CategoryId) (*Category, error)
-	mustEmbedUnimplementedCategoryServiceServer()
-}
-
-// UnimplementedCategoryServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedCategoryServiceServer struct{}
-
-func (UnimplementedCategoryServiceServer) GetAllCategories(context.Context, *Empty) (*CategoryList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCategories not implemented")
-}
-func (UnimplementedCategoryServiceServer) GetCategoryByID(context.Context, *CategoryId) (*Category, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryByID not implemented")
-}
-func (UnimplementedCategoryServiceServer) mustEmbedUnimplementedCategoryServiceServer() {}
-func (UnimplementedCategoryServiceServer) testEmbeddedByValue()                         {}
-
-// UnsafeCategoryServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CategoryServiceServer will
-// result in compilation errors.
-type UnsafeCategoryServiceServer interface {
-	mustEmbedUnimplementedCategoryServiceServer()
-}
-
-func RegisterCategoryServiceServer(s grpc.ServiceRegistrar, srv CategoryServiceServer) {
-	// If the following call pancis, it indicates UnimplementedCategoryServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&CategoryService_ServiceDesc, srv)
-}
-
-func _CategoryService_GetAllCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CategoryServiceServer).GetAllCategories(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: CategoryService_GetAllCategories_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CategoryServiceServer).GetAllCategories(ctx, req.(*Empty))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _CategoryService_GetCategoryByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CategoryId)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CategoryServiceServer).GetCategoryByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: CategoryService_GetCategoryByID_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CategoryServiceServer).GetCategoryByID(ctx, req.(*CategoryId))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// CategoryService_ServiceDesc is the grpc.ServiceDesc for CategoryService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var CategoryService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.CategoryService",
-	HandlerType: (*CategoryServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetAllCategories",
-			Handler:    _CategoryService_GetAllCategories_Handler,
-		},
-		{
-			MethodName: "GetCategoryByID",
-			Handler:    _CategoryService_GetCategoryByID_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/category.proto",
-}
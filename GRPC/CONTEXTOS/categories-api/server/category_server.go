@@ -2,37 +2,74 @@ package server
 
 import (
 	"context"
-	"errors"
-	"fmt"
 
-	pb "categories-api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"shared/dataset"
+	"shared/grpcserver"
+	pb "shared/proto/category"
+	"shared/repository"
 )
 
 type CategoryServer struct {
 	pb.UnimplementedCategoryServiceServer
-	categories []*pb.Category
+	categories *repository.Repository[*pb.Category]
+	writes     *grpcserver.Writes[*pb.Category]
 }
 
-func NewCategoryServer() *CategoryServer {
-	var categories []*pb.Category
-	for i := 1; i <= 100; i++ {
-		categories = append(categories, &pb.Category{
-			Id:   int32(i),
-			Name: fmt.Sprintf("Category %d", i),
-		})
-	}
-	return &CategoryServer{categories: categories}
+func NewCategoryServer(size int) *CategoryServer {
+	categories := pb.ListFromDomain(dataset.Categories(size)).Categories
+	repo := repository.New(categories, func(c *pb.Category) int { return int(c.Id) })
+	return &CategoryServer{categories: repo, writes: newWrites(repo)}
 }
 
-func (s *CategoryServer) GetAllCategories(ctx context.Context, _ *pb.Empty) (*pb.CategoryList, error) {
-	return &pb.CategoryList{Categories: s.categories}, nil
+func (s *CategoryServer) GetAllCategories(ctx context.Context, _ *emptypb.Empty) (*pb.CategoryList, error) {
+	return &pb.CategoryList{Categories: s.categories.All()}, nil
+}
+
+// StreamCategories envia o catálogo item a item; o cliente recebe os primeiros
+// antes do último ser enviado
+func (s *CategoryServer) StreamCategories(_ *emptypb.Empty, stream grpc.ServerStreamingServer[pb.Category]) error {
+	for _, item := range s.categories.All() {
+		if err := stream.Send(item); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *CategoryServer) GetCategoryByID(ctx context.Context, req *pb.CategoryId) (*pb.Category, error) {
-	for _, c := range s.categories {
-		if c.Id == req.Id {
-			return c, nil
+	if req.Id < 1 {
+		return nil, errorDomain.InvalidID(req.Id)
+	}
+	if c, ok := s.categories.ByID(int(req.Id)); ok {
+		return c, nil
+	}
+	return nil, categoryNotFound(req.Id)
+}
+
+// GetCategoriesByIDs devolve as categorias na ordem pedida; IDs inexistentes
+// ficam de fora da lista
+func (s *CategoryServer) GetCategoriesByIDs(ctx context.Context, req *pb.CategoryIds) (*pb.CategoryList, error) {
+	list := &pb.CategoryList{}
+	for _, id := range req.Ids {
+		if c, ok := s.categories.ByID(int(id)); ok {
+			list.Categories = append(list.Categories, c)
 		}
 	}
-	return nil, errors.New("categoria não encontrada")
+	return list, nil
+}
+
+// EnrichStream responde cada pedido com a busca da chamada unária; um ID
+// inexistente vira erro na resposta e não encerra o stream
+func (s *CategoryServer) EnrichStream(stream grpc.BidiStreamingServer[pb.CategoryStreamRequest, pb.CategoryStreamResponse]) error {
+	return grpcserver.EnrichStream(stream, (*pb.CategoryStreamRequest).GetTag, (*pb.CategoryStreamRequest).GetId,
+		func(ctx context.Context, id int32) (*pb.Category, error) {
+			return s.GetCategoryByID(ctx, &pb.CategoryId{Id: id})
+		},
+		func(tag uint64, category *pb.Category, st *status.Status) *pb.CategoryStreamResponse {
+			return &pb.CategoryStreamResponse{Tag: tag, Category: category, Error: st.Message(), Code: int32(st.Code())}
+		})
 }
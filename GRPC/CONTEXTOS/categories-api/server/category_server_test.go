@@ -0,0 +1,240 @@
+package server
+
+import (
+	"context"
+	"io"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"shared/dataset"
+	"shared/grpcserver/grpctest"
+	pb "shared/proto/category"
+)
+
+const catalogSize = 20
+
+// newClient registra um CategoryServer em um listener em memória e devolve um
+// cliente ligado a ele
+func newClient(t *testing.T) pb.CategoryServiceClient {
+	t.Helper()
+	conn := grpctest.Dial(t, func(s *grpc.Server) { pb.RegisterCategoryServiceServer(s, NewCategoryServer(catalogSize)) })
+	return pb.NewCategoryServiceClient(conn)
+}
+
+// checkStatus confere o código e o motivo do ErrorInfo de err
+func checkStatus(t *testing.T, err error, code codes.Code, reason string) {
+	t.Helper()
+	grpctest.CheckStatus(t, err, code, errorDomain, reason)
+}
+
+func TestGetAllCategories(t *testing.T) {
+	client := newClient(t)
+
+	list, err := client.GetAllCategories(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := dataset.Categories(catalogSize)
+	if len(list.Categories) != len(want) {
+		t.Fatalf("%d categorias, esperado %d", len(list.Categories), len(want))
+	}
+	for i, c := range list.Categories {
+		if int(c.Id) != want[i].ID || c.Name != want[i].Name {
+			t.Errorf("posição %d: %d %q, esperado %d %q", i, c.Id, c.Name, want[i].ID, want[i].Name)
+		}
+	}
+}
+
+func TestGetCategoryByID(t *testing.T) {
+	client := newClient(t)
+
+	for _, want := range dataset.Categories(catalogSize) {
+		c, err := client.GetCategoryByID(context.Background(), &pb.CategoryId{Id: int32(want.ID)})
+		if err != nil {
+			t.Fatalf("%d: %v", want.ID, err)
+		}
+		if c.Name != want.Name {
+			t.Errorf("%d: %v, esperado %+v", want.ID, c, want)
+		}
+	}
+}
+
+func TestGetCategoryByIDNotFound(t *testing.T) {
+	client := newClient(t)
+
+	_, err := client.GetCategoryByID(context.Background(), &pb.CategoryId{Id: catalogSize + 1})
+	checkStatus(t, err, codes.NotFound, "CATEGORY_NOT_FOUND")
+}
+
+func TestGetCategoryByIDInvalid(t *testing.T) {
+	client := newClient(t)
+
+	for _, id := range []int32{0, -1} {
+		_, err := client.GetCategoryByID(context.Background(), &pb.CategoryId{Id: id})
+		checkStatus(t, err, codes.InvalidArgument, "INVALID_ID")
+	}
+}
+
+// GetCategoriesByIDs devolve na ordem pedida e deixa de fora os IDs que não
+// existem
+func TestGetCategoriesByIDs(t *testing.T) {
+	client := newClient(t)
+
+	ids := []int32{5, 0, 2, catalogSize + 1, 5, -3, 1}
+	list, err := client.GetCategoriesByIDs(context.Background(), &pb.CategoryIds{Ids: ids})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []int32
+	for _, c := range list.Categories {
+		got = append(got, c.Id)
+	}
+	if want := []int32{5, 2, 5, 1}; !slices.Equal(got, want) {
+		t.Errorf("IDs %v, esperado %v", got, want)
+	}
+}
+
+func TestGetCategoriesByIDsEmpty(t *testing.T) {
+	client := newClient(t)
+
+	list, err := client.GetCategoriesByIDs(context.Background(), &pb.CategoryIds{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Categories) != 0 {
+		t.Errorf("%d categorias para uma lista vazia de IDs", len(list.Categories))
+	}
+}
+
+// As regras das escritas são testadas em shared/grpcserver; aqui fica a
+// ligação das RPCs com o repositório e os motivos dos erros do contexto
+func TestWrites(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateCategory(ctx, &pb.Category{Name: "Categoria Nova"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := client.GetCategoryByID(ctx, &pb.CategoryId{Id: created.Id}); err != nil || !proto.Equal(got, created) {
+		t.Errorf("%v %v, esperado %v", got, err, created)
+	}
+	_, err = client.CreateCategory(ctx, &pb.Category{Name: ""})
+	checkStatus(t, err, codes.InvalidArgument, "INVALID_CATEGORY")
+
+	want := proto.Clone(created).(*pb.Category)
+	want.Name = "Alterada"
+	if updated, err := client.UpdateCategory(ctx, want); err != nil || !proto.Equal(updated, want) {
+		t.Errorf("%v %v, esperado %v", updated, err, want)
+	}
+	want.Name = "Alterada de novo"
+	req := &pb.PatchCategoryRequest{Category: &pb.Category{Id: created.Id, Name: want.Name}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}}}
+	if patched, err := client.PatchCategory(ctx, req); err != nil || !proto.Equal(patched, want) {
+		t.Errorf("%v %v, esperado %v", patched, err, want)
+	}
+
+	if _, err := client.DeleteCategory(ctx, &pb.CategoryId{Id: created.Id}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.DeleteCategory(ctx, &pb.CategoryId{Id: created.Id})
+	checkStatus(t, err, codes.NotFound, "CATEGORY_NOT_FOUND")
+}
+
+// O EnrichStream responde na ordem dos pedidos, com a tag de cada um; as
+// falhas trazem o código da chamada unária e não encerram o stream
+func TestEnrichStream(t *testing.T) {
+	stream, err := newClient(t).EnrichStream(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		id   int32
+		code codes.Code
+	}{{3, codes.OK}, {catalogSize + 1, codes.NotFound}, {1, codes.OK}, {0, codes.InvalidArgument}}
+	for i, c := range cases {
+		if err := stream.Send(&pb.CategoryStreamRequest{Tag: uint64(i), Id: c.id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stream.CloseSend()
+
+	for i, c := range cases {
+		res, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Tag != uint64(i) || codes.Code(res.Code) != c.code || (res.Error == "") != (c.code == codes.OK) {
+			t.Errorf("%d: tag %d, código %v %q; esperado tag %d, código %v", c.id, res.Tag, codes.Code(res.Code), res.Error, i, c.code)
+		}
+		if c.code == codes.OK && res.GetCategory().GetId() != c.id {
+			t.Errorf("%d: %v", c.id, res.GetCategory())
+		}
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("%v, esperado io.EOF", err)
+	}
+}
+
+// StreamCategories envia o catálogo inteiro, na ordem de GetAllCategories
+func TestStreamCategories(t *testing.T) {
+	stream, err := newClient(t).StreamCategories(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []*pb.Category
+	for {
+		item, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+	}
+
+	want := dataset.Categories(catalogSize)
+	if len(got) != len(want) {
+		t.Fatalf("%d categorias, esperado %d", len(got), len(want))
+	}
+	for i, item := range got {
+		if !proto.Equal(item, pb.FromDomain(want[i])) {
+			t.Errorf("posição %d: %v, esperado %+v", i, item, want[i])
+		}
+	}
+}
+
+// Cancelar o cliente no meio do stream faz o servidor parar de enviar; o
+// catálogo é grande para não caber nos buffers da conexão
+func TestStreamCategoriesCanceled(t *testing.T) {
+	const size = 100_000
+	results := make(chan grpctest.StreamResult, 1)
+	conn := grpctest.Dial(t, func(s *grpc.Server) { pb.RegisterCategoryServiceServer(s, NewCategoryServer(size)) }, grpctest.RecordStreams(results))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := pb.NewCategoryServiceClient(conn).StreamCategories(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	select {
+	case r := <-results:
+		if r.Err == nil || r.Sent >= size {
+			t.Errorf("servidor enviou %d de %d e terminou com %v, esperado erro antes do fim", r.Sent, size, r.Err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("servidor continua enviando depois do cancelamento")
+	}
+}
@@ -0,0 +1,14 @@
+package server
+
+import "shared/grpcserver"
+
+// errorDomain identifica o serviço no ErrorInfo dos erros
+const errorDomain grpcserver.Domain = "categories-api"
+
+func categoryNotFound(id int32) error {
+	return errorDomain.NotFound("CATEGORY_NOT_FOUND", "categoria não encontrada", id)
+}
+
+func invalidCategory(id int32, err error) error {
+	return errorDomain.Invalid("INVALID_CATEGORY", "categoria inválida", id, err)
+}
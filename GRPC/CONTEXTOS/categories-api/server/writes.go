@@ -0,0 +1,41 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"shared/grpcserver"
+	pb "shared/proto/category"
+	"shared/repository"
+)
+
+// newWrites liga as escritas ao repositório de categorias
+func newWrites(categories *repository.Repository[*pb.Category]) *grpcserver.Writes[*pb.Category] {
+	return &grpcserver.Writes[*pb.Category]{
+		Repo:     categories,
+		Errors:   errorDomain,
+		Validate: func(c *pb.Category) error { return c.ToDomain().Validate() },
+		Invalid:  invalidCategory,
+		NotFound: categoryNotFound,
+	}
+}
+
+func (s *CategoryServer) CreateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
+	return s.writes.Create(req)
+}
+
+func (s *CategoryServer) UpdateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
+	return s.writes.Update(req.Id, req)
+}
+
+func (s *CategoryServer) PatchCategory(ctx context.Context, req *pb.PatchCategoryRequest) (*pb.Category, error) {
+	return s.writes.Patch(req.GetCategory().GetId(), req.GetCategory(), req.GetUpdateMask().GetPaths())
+}
+
+func (s *CategoryServer) DeleteCategory(ctx context.Context, req *pb.CategoryId) (*emptypb.Empty, error) {
+	if err := s.writes.Delete(req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -4,8 +4,9 @@ import (
 	"log"
 	"net"
 
-	pb "images-api/proto"
 	"images-api/server"
+	"shared/dataset"
+	pb "shared/proto/image"
 
 	"google.golang.org/grpc"
 )
@@ -16,8 +17,13 @@ func main() {
 		log.Fatalf("Erro ao escutar: %v", err)
 	}
 
+	cfg, err := dataset.Load()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	s := grpc.NewServer()
-	pb.RegisterImageServiceServer(s, server.NewImageServer())
+	pb.RegisterImageServiceServer(s, server.NewImageServer(cfg.Size))
 
 	log.Println("Servidor gRPC de image rodando na porta 8080")
 	if err := s.Serve(lis); err != nil {
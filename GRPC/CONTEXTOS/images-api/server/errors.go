@@ -0,0 +1,14 @@
+package server
+
+import "shared/grpcserver"
+
+// errorDomain identifica o serviço no ErrorInfo dos erros
+const errorDomain grpcserver.Domain = "images-api"
+
+func imageNotFound(id int32) error {
+	return errorDomain.NotFound("IMAGE_NOT_FOUND", "imagem não encontrada", id)
+}
+
+func invalidImage(id int32, err error) error {
+	return errorDomain.Invalid("INVALID_IMAGE", "imagem inválida", id, err)
+}
@@ -2,37 +2,74 @@ package server
 
 import (
 	"context"
-	"errors"
-	"fmt"
 
-	pb "images-api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"shared/dataset"
+	"shared/grpcserver"
+	pb "shared/proto/image"
+	"shared/repository"
 )
 
 type ImageServer struct {
 	pb.UnimplementedImageServiceServer
-	images []*pb.Image
+	images *repository.Repository[*pb.Image]
+	writes *grpcserver.Writes[*pb.Image]
 }
 
-func NewImageServer() *ImageServer {
-	var images []*pb.Image
-	for i := 1; i <= 100; i++ {
-		images = append(images, &pb.Image{
-			Id:  int32(i),
-			Url: fmt.Sprintf("https://example.com/image%d.jpg", i),
-		})
-	}
-	return &ImageServer{images: images}
+func NewImageServer(size int) *ImageServer {
+	images := pb.ListFromDomain(dataset.Images(size)).Images
+	repo := repository.New(images, func(i *pb.Image) int { return int(i.Id) })
+	return &ImageServer{images: repo, writes: newWrites(repo)}
 }
 
-func (s *ImageServer) GetAllImages(ctx context.Context, _ *pb.Empty) (*pb.ImageList, error) {
-	return &pb.ImageList{Images: s.images}, nil
+func (s *ImageServer) GetAllImages(ctx context.Context, _ *emptypb.Empty) (*pb.ImageList, error) {
+	return &pb.ImageList{Images: s.images.All()}, nil
+}
+
+// StreamImages envia o catálogo item a item; o cliente recebe os primeiros
+// antes do último ser enviado
+func (s *ImageServer) StreamImages(_ *emptypb.Empty, stream grpc.ServerStreamingServer[pb.Image]) error {
+	for _, item := range s.images.All() {
+		if err := stream.Send(item); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *ImageServer) GetImageByID(ctx context.Context, req *pb.ImageId) (*pb.Image, error) {
-	for _, item := range s.images {
-		if item.Id == req.Id {
-			return item, nil
+	if req.Id < 1 {
+		return nil, errorDomain.InvalidID(req.Id)
+	}
+	if item, ok := s.images.ByID(int(req.Id)); ok {
+		return item, nil
+	}
+	return nil, imageNotFound(req.Id)
+}
+
+// GetImagesByIDs devolve as imagens na ordem pedida; IDs inexistentes ficam
+// de fora da lista
+func (s *ImageServer) GetImagesByIDs(ctx context.Context, req *pb.ImageIds) (*pb.ImageList, error) {
+	list := &pb.ImageList{}
+	for _, id := range req.Ids {
+		if item, ok := s.images.ByID(int(id)); ok {
+			list.Images = append(list.Images, item)
 		}
 	}
-	return nil, errors.New("image não encontrado")
+	return list, nil
+}
+
+// EnrichStream responde cada pedido com a busca da chamada unária; um ID
+// inexistente vira erro na resposta e não encerra o stream
+func (s *ImageServer) EnrichStream(stream grpc.BidiStreamingServer[pb.ImageStreamRequest, pb.ImageStreamResponse]) error {
+	return grpcserver.EnrichStream(stream, (*pb.ImageStreamRequest).GetTag, (*pb.ImageStreamRequest).GetId,
+		func(ctx context.Context, id int32) (*pb.Image, error) {
+			return s.GetImageByID(ctx, &pb.ImageId{Id: id})
+		},
+		func(tag uint64, image *pb.Image, st *status.Status) *pb.ImageStreamResponse {
+			return &pb.ImageStreamResponse{Tag: tag, Image: image, Error: st.Message(), Code: int32(st.Code())}
+		})
 }
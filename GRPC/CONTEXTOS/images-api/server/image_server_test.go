@@ -0,0 +1,240 @@
+package server
+
+import (
+	"context"
+	"io"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"shared/dataset"
+	"shared/grpcserver/grpctest"
+	pb "shared/proto/image"
+)
+
+const catalogSize = 20
+
+// newClient registra um ImageServer em um listener em memória e devolve um
+// cliente ligado a ele
+func newClient(t *testing.T) pb.ImageServiceClient {
+	t.Helper()
+	conn := grpctest.Dial(t, func(s *grpc.Server) { pb.RegisterImageServiceServer(s, NewImageServer(catalogSize)) })
+	return pb.NewImageServiceClient(conn)
+}
+
+// checkStatus confere o código e o motivo do ErrorInfo de err
+func checkStatus(t *testing.T, err error, code codes.Code, reason string) {
+	t.Helper()
+	grpctest.CheckStatus(t, err, code, errorDomain, reason)
+}
+
+func TestGetAllImages(t *testing.T) {
+	client := newClient(t)
+
+	list, err := client.GetAllImages(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := dataset.Images(catalogSize)
+	if len(list.Images) != len(want) {
+		t.Fatalf("%d imagens, esperado %d", len(list.Images), len(want))
+	}
+	for i, img := range list.Images {
+		if int(img.Id) != want[i].ID || img.Url != want[i].URL {
+			t.Errorf("posição %d: %d %q, esperado %d %q", i, img.Id, img.Url, want[i].ID, want[i].URL)
+		}
+	}
+}
+
+func TestGetImageByID(t *testing.T) {
+	client := newClient(t)
+
+	for _, want := range dataset.Images(catalogSize) {
+		img, err := client.GetImageByID(context.Background(), &pb.ImageId{Id: int32(want.ID)})
+		if err != nil {
+			t.Fatalf("%d: %v", want.ID, err)
+		}
+		if img.Url != want.URL {
+			t.Errorf("%d: %v, esperado %+v", want.ID, img, want)
+		}
+	}
+}
+
+func TestGetImageByIDNotFound(t *testing.T) {
+	client := newClient(t)
+
+	_, err := client.GetImageByID(context.Background(), &pb.ImageId{Id: catalogSize + 1})
+	checkStatus(t, err, codes.NotFound, "IMAGE_NOT_FOUND")
+}
+
+func TestGetImageByIDInvalid(t *testing.T) {
+	client := newClient(t)
+
+	for _, id := range []int32{0, -1} {
+		_, err := client.GetImageByID(context.Background(), &pb.ImageId{Id: id})
+		checkStatus(t, err, codes.InvalidArgument, "INVALID_ID")
+	}
+}
+
+// GetImagesByIDs devolve na ordem pedida e deixa de fora os IDs que não
+// existem
+func TestGetImagesByIDs(t *testing.T) {
+	client := newClient(t)
+
+	ids := []int32{5, 0, 2, catalogSize + 1, 5, -3, 1}
+	list, err := client.GetImagesByIDs(context.Background(), &pb.ImageIds{Ids: ids})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []int32
+	for _, img := range list.Images {
+		got = append(got, img.Id)
+	}
+	if want := []int32{5, 2, 5, 1}; !slices.Equal(got, want) {
+		t.Errorf("IDs %v, esperado %v", got, want)
+	}
+}
+
+func TestGetImagesByIDsEmpty(t *testing.T) {
+	client := newClient(t)
+
+	list, err := client.GetImagesByIDs(context.Background(), &pb.ImageIds{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Images) != 0 {
+		t.Errorf("%d imagens para uma lista vazia de IDs", len(list.Images))
+	}
+}
+
+// As regras das escritas são testadas em shared/grpcserver; aqui fica a
+// ligação das RPCs com o repositório e os motivos dos erros do contexto
+func TestWrites(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateImage(ctx, &pb.Image{Url: "https://example.com/nova.jpg"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := client.GetImageByID(ctx, &pb.ImageId{Id: created.Id}); err != nil || !proto.Equal(got, created) {
+		t.Errorf("%v %v, esperado %v", got, err, created)
+	}
+	_, err = client.CreateImage(ctx, &pb.Image{Url: "nova.jpg"})
+	checkStatus(t, err, codes.InvalidArgument, "INVALID_IMAGE")
+
+	want := proto.Clone(created).(*pb.Image)
+	want.Url = "https://example.com/alterada.jpg"
+	if updated, err := client.UpdateImage(ctx, want); err != nil || !proto.Equal(updated, want) {
+		t.Errorf("%v %v, esperado %v", updated, err, want)
+	}
+	want.Url = "https://example.com/outra.jpg"
+	req := &pb.PatchImageRequest{Image: &pb.Image{Id: created.Id, Url: want.Url}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"url"}}}
+	if patched, err := client.PatchImage(ctx, req); err != nil || !proto.Equal(patched, want) {
+		t.Errorf("%v %v, esperado %v", patched, err, want)
+	}
+
+	if _, err := client.DeleteImage(ctx, &pb.ImageId{Id: created.Id}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.DeleteImage(ctx, &pb.ImageId{Id: created.Id})
+	checkStatus(t, err, codes.NotFound, "IMAGE_NOT_FOUND")
+}
+
+// O EnrichStream responde na ordem dos pedidos, com a tag de cada um; as
+// falhas trazem o código da chamada unária e não encerram o stream
+func TestEnrichStream(t *testing.T) {
+	stream, err := newClient(t).EnrichStream(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		id   int32
+		code codes.Code
+	}{{3, codes.OK}, {catalogSize + 1, codes.NotFound}, {1, codes.OK}, {0, codes.InvalidArgument}}
+	for i, c := range cases {
+		if err := stream.Send(&pb.ImageStreamRequest{Tag: uint64(i), Id: c.id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stream.CloseSend()
+
+	for i, c := range cases {
+		res, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Tag != uint64(i) || codes.Code(res.Code) != c.code || (res.Error == "") != (c.code == codes.OK) {
+			t.Errorf("%d: tag %d, código %v %q; esperado tag %d, código %v", c.id, res.Tag, codes.Code(res.Code), res.Error, i, c.code)
+		}
+		if c.code == codes.OK && res.GetImage().GetId() != c.id {
+			t.Errorf("%d: %v", c.id, res.GetImage())
+		}
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("%v, esperado io.EOF", err)
+	}
+}
+
+// StreamImages envia o catálogo inteiro, na ordem de GetAllImages
+func TestStreamImages(t *testing.T) {
+	stream, err := newClient(t).StreamImages(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []*pb.Image
+	for {
+		item, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+	}
+
+	want := dataset.Images(catalogSize)
+	if len(got) != len(want) {
+		t.Fatalf("%d imagens, esperado %d", len(got), len(want))
+	}
+	for i, item := range got {
+		if !proto.Equal(item, pb.FromDomain(want[i])) {
+			t.Errorf("posição %d: %v, esperado %+v", i, item, want[i])
+		}
+	}
+}
+
+// Cancelar o cliente no meio do stream faz o servidor parar de enviar; o
+// catálogo é grande para não caber nos buffers da conexão
+func TestStreamImagesCanceled(t *testing.T) {
+	const size = 100_000
+	results := make(chan grpctest.StreamResult, 1)
+	conn := grpctest.Dial(t, func(s *grpc.Server) { pb.RegisterImageServiceServer(s, NewImageServer(size)) }, grpctest.RecordStreams(results))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := pb.NewImageServiceClient(conn).StreamImages(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	select {
+	case r := <-results:
+		if r.Err == nil || r.Sent >= size {
+			t.Errorf("servidor enviou %d de %d e terminou com %v, esperado erro antes do fim", r.Sent, size, r.Err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("servidor continua enviando depois do cancelamento")
+	}
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"shared/grpcserver"
+	pb "shared/proto/image"
+	"shared/repository"
+)
+
+// newWrites liga as escritas ao repositório de imagens
+func newWrites(images *repository.Repository[*pb.Image]) *grpcserver.Writes[*pb.Image] {
+	return &grpcserver.Writes[*pb.Image]{
+		Repo:     images,
+		Errors:   errorDomain,
+		Validate: func(i *pb.Image) error { return i.ToDomain().Validate() },
+		Invalid:  invalidImage,
+		NotFound: imageNotFound,
+	}
+}
+
+func (s *ImageServer) CreateImage(ctx context.Context, req *pb.Image) (*pb.Image, error) {
+	return s.writes.Create(req)
+}
+
+func (s *ImageServer) UpdateImage(ctx context.Context, req *pb.Image) (*pb.Image, error) {
+	return s.writes.Update(req.Id, req)
+}
+
+func (s *ImageServer) PatchImage(ctx context.Context, req *pb.PatchImageRequest) (*pb.Image, error) {
+	return s.writes.Patch(req.GetImage().GetId(), req.GetImage(), req.GetUpdateMask().GetPaths())
+}
+
+func (s *ImageServer) DeleteImage(ctx context.Context, req *pb.ImageId) (*emptypb.Empty, error) {
+	if err := s.writes.Delete(req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -3,9 +3,11 @@ package main
 import (
 	"log"
 	"net"
+	"os"
 
-	pb "products-api/proto"
 	"products-api/server"
+	"shared/dataset"
+	pb "shared/proto/product"
 
 	"google.golang.org/grpc"
 )
@@ -16,8 +18,31 @@ func main() {
 		log.Fatalf("Erro ao escutar: %v", err)
 	}
 
+	cfg, err := dataset.Load()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	productServer := server.NewProductServer(cfg)
+	log.Printf("Catálogo com %d produtos gerado com seed %d", cfg.Size, cfg.Seed)
+
+	// O produto enriquecido só existe quando os outros contextos estão configurados
+	endpoints := server.Endpoints{
+		Brands:     os.Getenv("BRANDS_API"),
+		Sellers:    os.Getenv("SELLERS_API"),
+		Categories: os.Getenv("CATEGORIES_API"),
+		Images:     os.Getenv("IMAGES_API"),
+	}
+	if endpoints.Brands != "" && endpoints.Sellers != "" && endpoints.Categories != "" && endpoints.Images != "" {
+		if err := productServer.EnableEnrichment(endpoints); err != nil {
+			log.Fatalf("Erro ao conectar nos contextos: %v", err)
+		}
+		defer productServer.Close()
+		log.Println("GetEnrichedProduct habilitado")
+	}
+
 	s := grpc.NewServer()
-	pb.RegisterProductServiceServer(s, server.NewProductServer())
+	pb.RegisterProductServiceServer(s, productServer)
 
 	log.Println("Servidor gRPC de product rodando na porta 8080")
 	if err := s.Serve(lis); err != nil {
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	brandpb "shared/proto/brand"
+	categorypb "shared/proto/category"
+	imagepb "shared/proto/image"
+	pb "shared/proto/product"
+	sellerpb "shared/proto/seller"
+)
+
+// Endpoints são os host:porta dos outros contextos gRPC
+type Endpoints struct {
+	Brands     string
+	Sellers    string
+	Categories string
+	Images     string
+}
+
+// enricher guarda os clientes usados por GetEnrichedProduct
+type enricher struct {
+	conns []*grpc.ClientConn
+
+	brand    brandpb.BrandServiceClient
+	seller   sellerpb.SellerServiceClient
+	category categorypb.CategoryServiceClient
+	image    imagepb.ImageServiceClient
+}
+
+// EnableEnrichment conecta nos outros contextos e habilita GetEnrichedProduct;
+// opts se somam às credenciais inseguras
+func (s *ProductServer) EnableEnrichment(endpoints Endpoints, opts ...grpc.DialOption) error {
+	e := &enricher{}
+	for _, target := range []string{endpoints.Brands, endpoints.Sellers, endpoints.Categories, endpoints.Images} {
+		conn, err := grpc.NewClient(target, append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)...)
+		if err != nil {
+			return errors.Join(err, e.close())
+		}
+		e.conns = append(e.conns, conn)
+	}
+	e.brand = brandpb.NewBrandServiceClient(e.conns[0])
+	e.seller = sellerpb.NewSellerServiceClient(e.conns[1])
+	e.category = categorypb.NewCategoryServiceClient(e.conns[2])
+	e.image = imagepb.NewImageServiceClient(e.conns[3])
+
+	s.enricher = e
+	return nil
+}
+
+// Close fecha as conexões abertas por EnableEnrichment
+func (s *ProductServer) Close() error {
+	if s.enricher == nil {
+		return nil
+	}
+	return s.enricher.close()
+}
+
+func (e *enricher) close() error {
+	var errs []error
+	for _, conn := range e.conns {
+		errs = append(errs, conn.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// GetEnrichedProduct monta o produto com as entidades dos outros contextos,
+// buscadas em paralelo como no /paralelo do BFF. Falhas deixam a entidade de
+// fora e entram em errors, com a posição que ela teria.
+func (s *ProductServer) GetEnrichedProduct(ctx context.Context, req *pb.Slug) (*pb.EnrichedProduct, error) {
+	if s.enricher == nil {
+		return nil, status.Error(codes.Unimplemented, "produto enriquecido desabilitado: configure BRANDS_API, SELLERS_API, CATEGORIES_API e IMAGES_API")
+	}
+
+	product, err := s.findBySlug(req.Slug)
+	if err != nil {
+		return nil, err
+	}
+
+	enriched := &pb.EnrichedProduct{
+		Id:          product.Id,
+		Name:        product.Name,
+		Slug:        product.Slug,
+		Description: product.Description,
+		Price:       product.Price,
+	}
+	categories := make([]*categorypb.Category, len(product.Categories))
+	images := make([]*imagepb.Image, len(product.Images))
+
+	var mu sync.Mutex
+	fail := func(field string, id int32, index int, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		enriched.Errors = append(enriched.Errors, &pb.EnrichError{Field: field, Id: id, Index: int32(index), Error: err.Error()})
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2 + len(product.Categories) + len(product.Images))
+
+	go func() {
+		defer wg.Done()
+		var err error
+		if enriched.Seller, err = s.enricher.seller.GetSellerByID(ctx, &sellerpb.SellerId{Id: product.SellerId}); err != nil {
+			fail("seller", product.SellerId, 0, err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		var err error
+		if enriched.Brand, err = s.enricher.brand.GetBrandByID(ctx, &brandpb.BrandRequest{Id: product.BrandId}); err != nil {
+			fail("brand", product.BrandId, 0, err)
+		}
+	}()
+
+	for i, id := range product.Categories {
+		go func() {
+			defer wg.Done()
+			var err error
+			if categories[i], err = s.enricher.category.GetCategoryByID(ctx, &categorypb.CategoryId{Id: id}); err != nil {
+				fail("categories", id, i, err)
+			}
+		}()
+	}
+
+	for i, id := range product.Images {
+		go func() {
+			defer wg.Done()
+			var err error
+			if images[i], err = s.enricher.image.GetImageByID(ctx, &imagepb.ImageId{Id: id}); err != nil {
+				fail("images", id, i, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// campos repeated não aceitam nil
+	for _, c := range categories {
+		if c != nil {
+			enriched.Categories = append(enriched.Categories, c)
+		}
+	}
+	for _, img := range images {
+		if img != nil {
+			enriched.Images = append(enriched.Images, img)
+		}
+	}
+	return enriched, nil
+}
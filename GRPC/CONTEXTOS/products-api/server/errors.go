@@ -0,0 +1,22 @@
+package server
+
+import (
+	"google.golang.org/grpc/codes"
+
+	"shared/grpcserver"
+)
+
+// errorDomain identifica o serviço no ErrorInfo dos erros
+const errorDomain grpcserver.Domain = "products-api"
+
+func productNotFound(id int32) error {
+	return errorDomain.NotFound("PRODUCT_NOT_FOUND", "produto não encontrado", id)
+}
+
+func invalidProduct(id int32, err error) error {
+	return errorDomain.Invalid("INVALID_PRODUCT", "produto inválido", id, err)
+}
+
+func duplicateSlug(slug string) error {
+	return errorDomain.Slug(codes.AlreadyExists, "DUPLICATE_SLUG", "slug já usado por outro produto", slug)
+}
@@ -2,64 +2,58 @@ package server
 
 import (
 	"context"
-	"errors"
-	"fmt"
-	"math/rand"
-	"strings"
 
-	pb "products-api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"shared/dataset"
+	"shared/grpcserver"
+	pb "shared/proto/product"
+	"shared/repository"
 )
 
 type ProductServer struct {
 	pb.UnimplementedProductServiceServer
-	products []*pb.Product
+	products *repository.Repository[*pb.Product]
+	writes   *grpcserver.Writes[*pb.Product]
+	// enricher fica nil até EnableEnrichment
+	enricher *enricher
 }
 
-func NewProductServer() *ProductServer {
-	var products []*pb.Product
-	for i := 1; i <= 100; i++ {
-		products = append(products, &pb.Product{
-			Id:          int32(i),
-			Name:        fmt.Sprintf("Product %d", i),
-			Slug:        fmt.Sprintf("nome-do-produto-%d", i),
-			Description: fmt.Sprintf("Descrição do produto %d", i),
-			Price: &pb.Price{
-				Original:     float32(rand.Intn(100) + 1),
-				SpecialPrice: float32(rand.Intn(100) + 1),
-			},
-			SellerId:   int32(rand.Intn(100) + 1),
-			BrandId:    int32(rand.Intn(100) + 1),
-			Categories: randomIDs(1),
-			Images:     randomIDs(1),
-		})
-	}
-	return &ProductServer{products: products}
+func NewProductServer(cfg dataset.Config) *ProductServer {
+	products := pb.ListFromDomain(dataset.Products(cfg)).Products
+	repo := repository.New(products, func(p *pb.Product) int { return int(p.Id) }).WithSlug(func(p *pb.Product) string { return p.Slug })
+	return &ProductServer{products: repo, writes: newWrites(repo)}
 }
 
-func randomIDs(n int) []int32 {
-	set := make(map[int]struct{})
-	var result []int32
+func (s *ProductServer) GetAllProducts(ctx context.Context, _ *emptypb.Empty) (*pb.ProductList, error) {
+	return &pb.ProductList{Products: s.products.All()}, nil
+}
 
-	for len(result) < n {
-		id := rand.Intn(100) + 1
-		if _, exists := set[id]; !exists {
-			set[id] = struct{}{}
-			result = append(result, int32(id))
+// StreamProducts envia o catálogo item a item; o cliente recebe os primeiros
+// antes do último ser enviado
+func (s *ProductServer) StreamProducts(_ *emptypb.Empty, stream grpc.ServerStreamingServer[pb.Product]) error {
+	for _, item := range s.products.All() {
+		if err := stream.Send(item); err != nil {
+			return err
 		}
 	}
-	return result
+	return nil
 }
 
-func (s *ProductServer) GetAllProducts(ctx context.Context, _ *pb.Empty) (*pb.ProductList, error) {
-	return &pb.ProductList{Products: s.products}, nil
+func (s *ProductServer) GetProductBySlug(ctx context.Context, req *pb.Slug) (*pb.Product, error) {
+	return s.findBySlug(req.Slug)
 }
 
-func (s *ProductServer) GetProductBySlug(ctx context.Context, req *pb.Slug) (*pb.Product, error) {
-	slug := strings.ToLower(req.Slug)
-	for _, item := range s.products {
-		if strings.ToLower(item.Slug) == slug {
-			return item, nil
-		}
+// findBySlug ignora maiúsculas; slug vazio é InvalidArgument e slug fora do
+// catálogo, NotFound
+func (s *ProductServer) findBySlug(slug string) (*pb.Product, error) {
+	if slug == "" {
+		return nil, errorDomain.Slug(codes.InvalidArgument, "INVALID_SLUG", "slug vazio", slug)
+	}
+	if item, ok := s.products.BySlug(slug); ok {
+		return item, nil
 	}
-	return nil, errors.New("product não encontrado")
+	return nil, errorDomain.Slug(codes.NotFound, "PRODUCT_NOT_FOUND", "produto não encontrado", slug)
 }
@@ -0,0 +1,427 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"shared/dataset"
+	"shared/domain"
+	"shared/grpcserver/grpctest"
+	brandpb "shared/proto/brand"
+	categorypb "shared/proto/category"
+	imagepb "shared/proto/image"
+	pb "shared/proto/product"
+	sellerpb "shared/proto/seller"
+)
+
+const catalogSize = 20
+
+func testConfig() dataset.Config {
+	cfg := dataset.DefaultConfig()
+	cfg.Size = catalogSize
+	cfg.Categories = dataset.Uniform(0, 4)
+	cfg.Images = dataset.Uniform(0, 4)
+	return cfg
+}
+
+func newClient(t *testing.T, server *ProductServer) pb.ProductServiceClient {
+	t.Helper()
+	conn := grpctest.Dial(t, func(s *grpc.Server) { pb.RegisterProductServiceServer(s, server) })
+	return pb.NewProductServiceClient(conn)
+}
+
+// checkStatus confere o código e o motivo do ErrorInfo de err
+func checkStatus(t *testing.T, err error, code codes.Code, reason string) {
+	t.Helper()
+	grpctest.CheckStatus(t, err, code, errorDomain, reason)
+}
+
+// Os outros contextos respondem do dataset; IDs em missing dão NotFound
+type brandStub struct {
+	brandpb.UnimplementedBrandServiceServer
+	missing map[int32]bool
+}
+
+func (s brandStub) GetBrandByID(ctx context.Context, req *brandpb.BrandRequest) (*brandpb.Brand, error) {
+	if s.missing[req.Id] || req.Id < 1 || req.Id > catalogSize {
+		return nil, status.Error(codes.NotFound, "marca não encontrada")
+	}
+	return brandpb.FromDomain(dataset.Brands(catalogSize)[req.Id-1]), nil
+}
+
+type sellerStub struct {
+	sellerpb.UnimplementedSellerServiceServer
+	missing map[int32]bool
+}
+
+func (s sellerStub) GetSellerByID(ctx context.Context, req *sellerpb.SellerId) (*sellerpb.Seller, error) {
+	if s.missing[req.Id] || req.Id < 1 || req.Id > catalogSize {
+		return nil, status.Error(codes.NotFound, "seller não encontrado")
+	}
+	return sellerpb.FromDomain(dataset.Sellers(catalogSize)[req.Id-1]), nil
+}
+
+type categoryStub struct {
+	categorypb.UnimplementedCategoryServiceServer
+	missing map[int32]bool
+}
+
+func (s categoryStub) GetCategoryByID(ctx context.Context, req *categorypb.CategoryId) (*categorypb.Category, error) {
+	if s.missing[req.Id] || req.Id < 1 || req.Id > catalogSize {
+		return nil, status.Error(codes.NotFound, "categoria não encontrada")
+	}
+	return categorypb.FromDomain(dataset.Categories(catalogSize)[req.Id-1]), nil
+}
+
+type imageStub struct {
+	imagepb.UnimplementedImageServiceServer
+	missing map[int32]bool
+}
+
+func (s imageStub) GetImageByID(ctx context.Context, req *imagepb.ImageId) (*imagepb.Image, error) {
+	if s.missing[req.Id] || req.Id < 1 || req.Id > catalogSize {
+		return nil, status.Error(codes.NotFound, "imagem não encontrada")
+	}
+	return imagepb.FromDomain(dataset.Images(catalogSize)[req.Id-1]), nil
+}
+
+// enrichedServer devolve um ProductServer com GetEnrichedProduct ligado aos
+// stubs, todos no mesmo listener
+func enrichedServer(t *testing.T, missing map[int32]bool) *ProductServer {
+	t.Helper()
+	dialer := grpctest.Listen(t, func(s *grpc.Server) {
+		brandpb.RegisterBrandServiceServer(s, brandStub{missing: missing})
+		sellerpb.RegisterSellerServiceServer(s, sellerStub{missing: missing})
+		categorypb.RegisterCategoryServiceServer(s, categoryStub{missing: missing})
+		imagepb.RegisterImageServiceServer(s, imageStub{missing: missing})
+	})
+
+	server := NewProductServer(testConfig())
+	target := grpctest.Target
+	if err := server.EnableEnrichment(Endpoints{Brands: target, Sellers: target, Categories: target, Images: target}, dialer); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { server.Close() })
+	return server
+}
+
+func TestGetAllProducts(t *testing.T) {
+	client := newClient(t, NewProductServer(testConfig()))
+
+	list, err := client.GetAllProducts(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := dataset.Products(testConfig())
+	if len(list.Products) != len(want) {
+		t.Fatalf("%d produtos, esperado %d", len(list.Products), len(want))
+	}
+	for i, p := range list.Products {
+		if int(p.Id) != want[i].ID || p.Slug != want[i].Slug {
+			t.Errorf("posição %d: %d %q, esperado %d %q", i, p.Id, p.Slug, want[i].ID, want[i].Slug)
+		}
+	}
+}
+
+func TestGetProductBySlug(t *testing.T) {
+	client := newClient(t, NewProductServer(testConfig()))
+
+	for _, want := range dataset.Products(testConfig()) {
+		// a busca ignora maiúsculas
+		for _, slug := range []string{want.Slug, strings.ToUpper(want.Slug)} {
+			p, err := client.GetProductBySlug(context.Background(), &pb.Slug{Slug: slug})
+			if err != nil {
+				t.Fatalf("%s: %v", slug, err)
+			}
+			if int(p.Id) != want.ID || int(p.SellerId) != want.SellerID || int(p.BrandId) != want.BrandID || len(p.Categories) != len(want.Categories) || len(p.Images) != len(want.Images) {
+				t.Errorf("%s: %v, esperado %+v", slug, p, want)
+			}
+		}
+	}
+}
+
+func TestGetProductBySlugNotFound(t *testing.T) {
+	client := newClient(t, NewProductServer(testConfig()))
+
+	_, err := client.GetProductBySlug(context.Background(), &pb.Slug{Slug: "produto-inexistente"})
+	checkStatus(t, err, codes.NotFound, "PRODUCT_NOT_FOUND")
+}
+
+func TestGetProductBySlugInvalid(t *testing.T) {
+	client := newClient(t, NewProductServer(testConfig()))
+
+	_, err := client.GetProductBySlug(context.Background(), &pb.Slug{})
+	checkStatus(t, err, codes.InvalidArgument, "INVALID_SLUG")
+}
+
+func TestGetEnrichedProductDisabled(t *testing.T) {
+	client := newClient(t, NewProductServer(testConfig()))
+
+	_, err := client.GetEnrichedProduct(context.Background(), &pb.Slug{Slug: "nome-do-produto-1"})
+	if status.Code(err) != codes.Unimplemented {
+		t.Fatalf("código %v, esperado Unimplemented", status.Code(err))
+	}
+}
+
+func TestGetEnrichedProduct(t *testing.T) {
+	client := newClient(t, enrichedServer(t, nil))
+
+	for _, want := range dataset.Products(testConfig()) {
+		p, err := client.GetEnrichedProduct(context.Background(), &pb.Slug{Slug: want.Slug})
+		if err != nil {
+			t.Fatalf("%s: %v", want.Slug, err)
+		}
+		if p.Seller == nil || int(p.Seller.Id) != want.SellerID {
+			t.Errorf("%s: seller %v, esperado %d", want.Slug, p.Seller, want.SellerID)
+		}
+		if p.Brand == nil || int(p.Brand.Id) != want.BrandID {
+			t.Errorf("%s: brand %v, esperado %d", want.Slug, p.Brand, want.BrandID)
+		}
+		if len(p.Categories) != len(want.Categories) {
+			t.Fatalf("%s: %d categorias, esperado %d", want.Slug, len(p.Categories), len(want.Categories))
+		}
+		for i, c := range p.Categories {
+			if int(c.Id) != want.Categories[i] {
+				t.Errorf("%s: categoria %d na posição %d, esperado %d", want.Slug, c.Id, i, want.Categories[i])
+			}
+		}
+		if len(p.Images) != len(want.Images) {
+			t.Fatalf("%s: %d imagens, esperado %d", want.Slug, len(p.Images), len(want.Images))
+		}
+		for i, img := range p.Images {
+			if int(img.Id) != want.Images[i] {
+				t.Errorf("%s: imagem %d na posição %d, esperado %d", want.Slug, img.Id, i, want.Images[i])
+			}
+		}
+	}
+}
+
+// Uma entidade que falha fica de fora sem derrubar o produto e aparece em
+// errors com a posição que teria
+func TestGetEnrichedProductMissingEntities(t *testing.T) {
+	products := dataset.Products(testConfig())
+	product := products[slices.IndexFunc(products, func(p domain.Product) bool { return len(p.Categories) > 1 })]
+	missing := map[int32]bool{int32(product.BrandID): true, int32(product.Categories[1]): true}
+	client := newClient(t, enrichedServer(t, missing))
+
+	p, err := client.GetEnrichedProduct(context.Background(), &pb.Slug{Slug: product.Slug})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// os stubs usam o mesmo missing para todos os contextos
+	var want []string
+	check := func(field string, id, index int) {
+		if missing[int32(id)] {
+			want = append(want, fmt.Sprintf("%s %d %d", field, id, index))
+		}
+	}
+	check("seller", product.SellerID, 0)
+	check("brand", product.BrandID, 0)
+	for i, id := range product.Categories {
+		check("categories", id, i)
+	}
+	for i, id := range product.Images {
+		check("images", id, i)
+	}
+	var got []string
+	for _, e := range p.Errors {
+		got = append(got, fmt.Sprintf("%s %d %d", e.Field, e.Id, e.Index))
+		if e.Error == "" {
+			t.Errorf("%s %d sem mensagem", e.Field, e.Id)
+		}
+	}
+	slices.Sort(got)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("erros %v, esperado %v", got, want)
+	}
+
+	if p.Brand != nil {
+		t.Errorf("brand %v, esperado nil", p.Brand)
+	}
+	// ToDomain devolve a categoria que falhou como nil na posição dela
+	categories := p.ToDomain().Categories
+	if len(categories) != len(product.Categories) || categories[1] != nil {
+		t.Fatalf("categorias %v, esperado nil na posição 1 de %d", categories, len(product.Categories))
+	}
+	for i, c := range categories {
+		if c != nil && c.ID != product.Categories[i] {
+			t.Errorf("categoria %d na posição %d, esperado %d", c.ID, i, product.Categories[i])
+		}
+	}
+}
+
+func TestGetEnrichedProductNotFound(t *testing.T) {
+	client := newClient(t, enrichedServer(t, nil))
+
+	_, err := client.GetEnrichedProduct(context.Background(), &pb.Slug{Slug: "produto-inexistente"})
+	checkStatus(t, err, codes.NotFound, "PRODUCT_NOT_FOUND")
+}
+
+func newProduct(slug string) *pb.Product {
+	return &pb.Product{Name: "Produto Novo", Slug: slug, Price: &pb.Price{Original: 100, SpecialPrice: 90}, SellerId: 1, BrandId: 2, Categories: []int32{3}, Images: []int32{4, 5}}
+}
+
+func TestCreateProduct(t *testing.T) {
+	client := newClient(t, NewProductServer(testConfig()))
+	ctx := context.Background()
+
+	created, err := client.CreateProduct(ctx, newProduct("produto-novo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Id != catalogSize+1 {
+		t.Errorf("ID %d, esperado %d", created.Id, catalogSize+1)
+	}
+	got, err := client.GetProductBySlug(ctx, &pb.Slug{Slug: "produto-novo"})
+	if err != nil || !proto.Equal(got, created) {
+		t.Errorf("%v %v, esperado %v", got, err, created)
+	}
+
+	_, err = client.CreateProduct(ctx, newProduct("produto-novo"))
+	checkStatus(t, err, codes.AlreadyExists, "DUPLICATE_SLUG")
+	_, err = client.CreateProduct(ctx, newProduct("Produto Novo"))
+	checkStatus(t, err, codes.InvalidArgument, "INVALID_PRODUCT")
+}
+
+func TestUpdateProduct(t *testing.T) {
+	client := newClient(t, NewProductServer(testConfig()))
+	ctx := context.Background()
+	products := dataset.Products(testConfig())
+
+	want := newProduct("slug-trocado")
+	want.Id = 3
+	updated, err := client.UpdateProduct(ctx, want)
+	if err != nil || !proto.Equal(updated, want) {
+		t.Fatalf("%v %v, esperado %v", updated, err, want)
+	}
+	// o slug antigo deixa de existir
+	_, err = client.GetProductBySlug(ctx, &pb.Slug{Slug: products[2].Slug})
+	checkStatus(t, err, codes.NotFound, "PRODUCT_NOT_FOUND")
+
+	conflict := newProduct(products[0].Slug)
+	conflict.Id = 3
+	_, err = client.UpdateProduct(ctx, conflict)
+	checkStatus(t, err, codes.AlreadyExists, "DUPLICATE_SLUG")
+}
+
+func TestPatchProduct(t *testing.T) {
+	client := newClient(t, NewProductServer(testConfig()))
+	ctx := context.Background()
+	slug := dataset.Products(testConfig())[1].Slug
+
+	before, err := client.GetProductBySlug(ctx, &pb.Slug{Slug: slug})
+	if err != nil {
+		t.Fatal(err)
+	}
+	patched, err := client.PatchProduct(ctx, &pb.PatchProductRequest{
+		Product:    &pb.Product{Id: before.Id, Name: "Alterado", Price: &pb.Price{SpecialPrice: 1}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"price.special_price", "images"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := proto.Clone(before).(*pb.Product)
+	want.Price.SpecialPrice = 1
+	want.Images = nil
+	if !proto.Equal(patched, want) {
+		t.Errorf("%v, esperado %v", patched, want)
+	}
+
+	_, err = client.PatchProduct(ctx, &pb.PatchProductRequest{Product: &pb.Product{Id: before.Id, Slug: "Inválido"}})
+	checkStatus(t, err, codes.InvalidArgument, "INVALID_PRODUCT")
+	_, err = client.PatchProduct(ctx, &pb.PatchProductRequest{Product: &pb.Product{Id: before.Id}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"price.desconto"}}})
+	checkStatus(t, err, codes.InvalidArgument, "INVALID_FIELD_MASK")
+}
+
+func TestDeleteProduct(t *testing.T) {
+	client := newClient(t, NewProductServer(testConfig()))
+	ctx := context.Background()
+	slug := dataset.Products(testConfig())[0].Slug
+
+	if _, err := client.DeleteProduct(ctx, &pb.ProductId{Id: 1}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := client.GetProductBySlug(ctx, &pb.Slug{Slug: slug})
+	checkStatus(t, err, codes.NotFound, "PRODUCT_NOT_FOUND")
+	_, err = client.DeleteProduct(ctx, &pb.ProductId{Id: 1})
+	checkStatus(t, err, codes.NotFound, "PRODUCT_NOT_FOUND")
+
+	// o slug removido fica livre para um novo produto
+	if _, err := client.CreateProduct(ctx, newProduct(slug)); err != nil {
+		t.Error(err)
+	}
+}
+
+// StreamProducts envia o catálogo inteiro, na ordem de GetAllProducts
+func TestStreamProducts(t *testing.T) {
+	client := newClient(t, NewProductServer(testConfig()))
+
+	stream, err := client.StreamProducts(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []*pb.Product
+	for {
+		item, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+	}
+
+	want := pb.ListFromDomain(dataset.Products(testConfig())).Products
+	if len(got) != len(want) {
+		t.Fatalf("%d produtos, esperado %d", len(got), len(want))
+	}
+	for i, item := range got {
+		if !proto.Equal(item, want[i]) {
+			t.Errorf("posição %d: %v, esperado %v", i, item, want[i])
+		}
+	}
+}
+
+// Cancelar o cliente no meio do stream faz o servidor parar de enviar; o
+// catálogo é grande para não caber nos buffers da conexão
+func TestStreamProductsCanceled(t *testing.T) {
+	cfg := testConfig()
+	cfg.Size = 50_000
+	results := make(chan grpctest.StreamResult, 1)
+	conn := grpctest.Dial(t, func(s *grpc.Server) { pb.RegisterProductServiceServer(s, NewProductServer(cfg)) }, grpctest.RecordStreams(results))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := pb.NewProductServiceClient(conn).StreamProducts(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	select {
+	case r := <-results:
+		if r.Err == nil || r.Sent >= cfg.Size {
+			t.Errorf("servidor enviou %d de %d e terminou com %v, esperado erro antes do fim", r.Sent, cfg.Size, r.Err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("servidor continua enviando depois do cancelamento")
+	}
+}
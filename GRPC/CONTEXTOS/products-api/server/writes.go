@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"shared/grpcserver"
+	pb "shared/proto/product"
+	"shared/repository"
+)
+
+// newWrites liga as escritas ao repositório de produtos; o slug continua
+// único, sem diferenciar maiúsculas, e um repetido é AlreadyExists
+func newWrites(products *repository.Repository[*pb.Product]) *grpcserver.Writes[*pb.Product] {
+	return &grpcserver.Writes[*pb.Product]{
+		Repo:     products,
+		Errors:   errorDomain,
+		Validate: func(p *pb.Product) error { return p.ToDomain().Validate() },
+		Invalid:  invalidProduct,
+		NotFound: productNotFound,
+		Conflict: func(p *pb.Product) error { return duplicateSlug(p.Slug) },
+	}
+}
+
+func (s *ProductServer) CreateProduct(ctx context.Context, req *pb.Product) (*pb.Product, error) {
+	return s.writes.Create(req)
+}
+
+func (s *ProductServer) UpdateProduct(ctx context.Context, req *pb.Product) (*pb.Product, error) {
+	return s.writes.Update(req.Id, req)
+}
+
+func (s *ProductServer) PatchProduct(ctx context.Context, req *pb.PatchProductRequest) (*pb.Product, error) {
+	return s.writes.Patch(req.GetProduct().GetId(), req.GetProduct(), req.GetUpdateMask().GetPaths())
+}
+
+func (s *ProductServer) DeleteProduct(ctx context.Context, req *pb.ProductId) (*emptypb.Empty, error) {
+	if err := s.writes.Delete(req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
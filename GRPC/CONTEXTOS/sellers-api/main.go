@@ -4,8 +4,9 @@ import (
 	"log"
 	"net"
 
-	pb "sellers-api/proto"
 	"sellers-api/server"
+	"shared/dataset"
+	pb "shared/proto/seller"
 
 	"google.golang.org/grpc"
 )
@@ -16,8 +17,13 @@ func main() {
 		log.Fatalf("Erro ao escutar: %v", err)
 	}
 
+	cfg, err := dataset.Load()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	s := grpc.NewServer()
-	pb.RegisterSellerServiceServer(s, server.NewSellerServer())
+	pb.RegisterSellerServiceServer(s, server.NewSellerServer(cfg.Size))
 
 	log.Println("Servidor gRPC de seller rodando na porta 8080")
 	if err := s.Serve(lis); err != nil {
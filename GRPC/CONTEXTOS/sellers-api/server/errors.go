@@ -0,0 +1,14 @@
+package server
+
+import "shared/grpcserver"
+
+// errorDomain identifica o serviço no ErrorInfo dos erros
+const errorDomain grpcserver.Domain = "sellers-api"
+
+func sellerNotFound(id int32) error {
+	return errorDomain.NotFound("SELLER_NOT_FOUND", "seller não encontrado", id)
+}
+
+func invalidSeller(id int32, err error) error {
+	return errorDomain.Invalid("INVALID_SELLER", "seller inválido", id, err)
+}
@@ -2,37 +2,62 @@ package server
 
 import (
 	"context"
-	"errors"
-	"fmt"
 
-	pb "sellers-api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"shared/dataset"
+	"shared/grpcserver"
+	pb "shared/proto/seller"
+	"shared/repository"
 )
 
 type SellerServer struct {
 	pb.UnimplementedSellerServiceServer
-	sellers []*pb.Seller
+	sellers *repository.Repository[*pb.Seller]
+	writes  *grpcserver.Writes[*pb.Seller]
 }
 
-func NewSellerServer() *SellerServer {
-	var sellers []*pb.Seller
-	for i := 1; i <= 100; i++ {
-		sellers = append(sellers, &pb.Seller{
-			Id:   int32(i),
-			Name: fmt.Sprintf("Seller %d", i),
-		})
-	}
-	return &SellerServer{sellers: sellers}
+func NewSellerServer(size int) *SellerServer {
+	sellers := pb.ListFromDomain(dataset.Sellers(size)).Sellers
+	repo := repository.New(sellers, func(i *pb.Seller) int { return int(i.Id) })
+	return &SellerServer{sellers: repo, writes: newWrites(repo)}
 }
 
-func (s *SellerServer) GetAllSellers(ctx context.Context, _ *pb.Empty) (*pb.SellerList, error) {
-	return &pb.SellerList{Sellers: s.sellers}, nil
+func (s *SellerServer) GetAllSellers(ctx context.Context, _ *emptypb.Empty) (*pb.SellerList, error) {
+	return &pb.SellerList{Sellers: s.sellers.All()}, nil
 }
 
-func (s *SellerServer) GetSellerByID(ctx context.Context, req *pb.SellerId) (*pb.Seller, error) {
-	for _, item := range s.sellers {
-		if item.Id == req.Id {
-			return item, nil
+// StreamSellers envia o catálogo item a item; o cliente recebe os primeiros
+// antes do último ser enviado
+func (s *SellerServer) StreamSellers(_ *emptypb.Empty, stream grpc.ServerStreamingServer[pb.Seller]) error {
+	for _, item := range s.sellers.All() {
+		if err := stream.Send(item); err != nil {
+			return err
 		}
 	}
-	return nil, errors.New("seller não encontrado")
+	return nil
+}
+
+func (s *SellerServer) GetSellerByID(ctx context.Context, req *pb.SellerId) (*pb.Seller, error) {
+	if req.Id < 1 {
+		return nil, errorDomain.InvalidID(req.Id)
+	}
+	if item, ok := s.sellers.ByID(int(req.Id)); ok {
+		return item, nil
+	}
+	return nil, sellerNotFound(req.Id)
+}
+
+// EnrichStream responde cada pedido com a busca da chamada unária; um ID
+// inexistente vira erro na resposta e não encerra o stream
+func (s *SellerServer) EnrichStream(stream grpc.BidiStreamingServer[pb.SellerStreamRequest, pb.SellerStreamResponse]) error {
+	return grpcserver.EnrichStream(stream, (*pb.SellerStreamRequest).GetTag, (*pb.SellerStreamRequest).GetId,
+		func(ctx context.Context, id int32) (*pb.Seller, error) {
+			return s.GetSellerByID(ctx, &pb.SellerId{Id: id})
+		},
+		func(tag uint64, seller *pb.Seller, st *status.Status) *pb.SellerStreamResponse {
+			return &pb.SellerStreamResponse{Tag: tag, Seller: seller, Error: st.Message(), Code: int32(st.Code())}
+		})
 }
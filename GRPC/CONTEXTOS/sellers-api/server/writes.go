@@ -0,0 +1,41 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"shared/grpcserver"
+	pb "shared/proto/seller"
+	"shared/repository"
+)
+
+// newWrites liga as escritas ao repositório de sellers
+func newWrites(sellers *repository.Repository[*pb.Seller]) *grpcserver.Writes[*pb.Seller] {
+	return &grpcserver.Writes[*pb.Seller]{
+		Repo:     sellers,
+		Errors:   errorDomain,
+		Validate: func(s *pb.Seller) error { return s.ToDomain().Validate() },
+		Invalid:  invalidSeller,
+		NotFound: sellerNotFound,
+	}
+}
+
+func (s *SellerServer) CreateSeller(ctx context.Context, req *pb.Seller) (*pb.Seller, error) {
+	return s.writes.Create(req)
+}
+
+func (s *SellerServer) UpdateSeller(ctx context.Context, req *pb.Seller) (*pb.Seller, error) {
+	return s.writes.Update(req.Id, req)
+}
+
+func (s *SellerServer) PatchSeller(ctx context.Context, req *pb.PatchSellerRequest) (*pb.Seller, error) {
+	return s.writes.Patch(req.GetSeller().GetId(), req.GetSeller(), req.GetUpdateMask().GetPaths())
+}
+
+func (s *SellerServer) DeleteSeller(ctx context.Context, req *pb.SellerId) (*emptypb.Empty, error) {
+	if err := s.writes.Delete(req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -1,5 +0,0 @@
-module bff
-
-go 1.24.1
-
-require github.com/gorilla/mux v1.8.1
@@ -1,76 +1,26 @@
 package main
 
 import (
-	"encoding/json"
+	"log"
 	"net/http"
-	"strconv"
 
-	"github.com/gorilla/mux"
+	"shared/dataset"
+	"shared/httpapi"
+	"shared/negotiate"
 )
 
-type Brand struct {
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Country     string `json:"country"`
-	Active      bool   `json:"active"`
-}
-
-var brands []Brand
-
-func init() {
-	descriptions := []string{
-		"Marca premium com presença global.",
-		"Referência em sustentabilidade.",
-		"Foco em design minimalista e funcional.",
-		"Marca líder em tecnologia de consumo.",
-		"Conhecida por produtos acessíveis e duráveis.",
-	}
-
-	countries := []string{"Brasil", "Estados Unidos", "Alemanha", "Japão"}
-
-	for i := 1; i <= 100; i++ {
-		brands = append(brands, Brand{
-			ID:          i,
-			Name:        "Brand " + strconv.Itoa(i),
-			Description: descriptions[i%len(descriptions)],
-			Country:     countries[i%len(countries)],
-			Active:      i%2 == 0,
-		})
-	}
-}
-
-func getAllBrands(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(brands)
-}
+// JSON por padrão; o Accept pode pedir qualquer outro formato
+var formats = negotiate.Offer(negotiate.JSON)
 
-func getBrandByID(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
-
-	vars := mux.Vars(r)
-	idStr := vars["brandId"]
-
-	id, err := strconv.Atoi(idStr)
+func main() {
+	cfg, err := dataset.Load()
 	if err != nil {
-		http.Error(w, "ID inválido", http.StatusBadRequest)
-		return
+		log.Fatal(err)
 	}
-
-	for _, b := range brands {
-		if b.ID == id {
-			json.NewEncoder(w).Encode(b)
-			return
-		}
+	r, err := httpapi.Brands(cfg.Size, formats)
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	http.Error(w, "Marca não encontrada", http.StatusNotFound)
-}
-
-func main() {
-	r := mux.NewRouter()
-	r.HandleFunc("/brands", getAllBrands).Methods("GET")
-	r.HandleFunc("/brands/{brandId}", getBrandByID).Methods("GET")
-
 	http.ListenAndServe(":8080", r)
 }
@@ -1,57 +1,26 @@
 package main
 
 import (
-	"encoding/json"
+	"log"
 	"net/http"
-	"strconv"
 
-	"github.com/gorilla/mux"
+	"shared/dataset"
+	"shared/httpapi"
+	"shared/negotiate"
 )
 
-type Image struct {
-	ID  int    `json:"id"`
-	URL string `json:"url"`
-}
-
-var images = []Image{}
-
-func init() {
-	for i := 1; i <= 100; i++ {
-		images = append(images, Image{ID: i, URL: "https://example.com/image" + strconv.Itoa(i) + ".jpg"})
-	}
-}
-
-func getAllImages(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(images)
-}
-
-func getImageByID(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
+// JSON por padrão; o Accept pode pedir qualquer outro formato
+var formats = negotiate.Offer(negotiate.JSON)
 
-	vars := mux.Vars(r)
-	idStr := vars["imageId"]
-
-	id, err := strconv.Atoi(idStr)
+func main() {
+	cfg, err := dataset.Load()
 	if err != nil {
-		http.Error(w, "ID inválido", http.StatusBadRequest)
-		return
+		log.Fatal(err)
 	}
-
-	for _, img := range images {
-		if img.ID == id {
-			json.NewEncoder(w).Encode(img)
-			return
-		}
+	r, err := httpapi.Images(cfg.Size, formats)
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	http.Error(w, "Imagem não encontrada", http.StatusNotFound)
-}
-
-func main() {
-	r := mux.NewRouter()
-	r.HandleFunc("/images", getAllImages).Methods("GET")
-	r.HandleFunc("/images/{imageId}", getImageByID).Methods("GET")
-
 	http.ListenAndServe(":8080", r)
 }
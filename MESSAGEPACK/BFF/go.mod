@@ -1,10 +0,0 @@
-module bff
-
-go 1.24.1
-
-require (
-	github.com/gorilla/mux v1.8.1
-	github.com/vmihailenco/msgpack/v5 v5.4.1
-)
-
-require github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
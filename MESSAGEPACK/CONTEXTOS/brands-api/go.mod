@@ -2,9 +2,20 @@ module brands-api
 
 go 1.24.1
 
+require shared v0.0.0
+
 require (
-	github.com/gorilla/mux v1.8.1
-	github.com/vmihailenco/msgpack/v5 v5.4.1
+	github.com/fxamacker/cbor/v2 v2.9.0 // indirect
+	github.com/gorilla/mux v1.8.1 // indirect
+	github.com/vmihailenco/msgpack/v5 v5.4.1 // indirect
+	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
+	github.com/x448/float16 v0.8.4 // indirect
+	golang.org/x/net v0.38.0 // indirect
+	golang.org/x/sys v0.31.0 // indirect
+	golang.org/x/text v0.23.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250324211829-b45e905df463 // indirect
+	google.golang.org/grpc v1.73.0 // indirect
+	google.golang.org/protobuf v1.36.6 // indirect
 )
 
-require github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
+replace shared => ../../../SHARED
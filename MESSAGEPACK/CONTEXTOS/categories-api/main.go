@@ -1,58 +1,26 @@
 package main
 
 import (
+	"log"
 	"net/http"
-	"strconv"
 
-	"github.com/vmihailenco/msgpack/v5"
-
-	"github.com/gorilla/mux"
+	"shared/dataset"
+	"shared/httpapi"
+	"shared/negotiate"
 )
 
-type Category struct {
-	ID   int    `msgpack:"id"`
-	Name string `msgpack:"name"`
-}
-
-var categories = []Category{}
-
-func init() {
-	for i := 1; i <= 100; i++ {
-		categories = append(categories, Category{ID: i, Name: "Category " + strconv.Itoa(i)})
-	}
-}
-
-func getAllCategories(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/x-msgpack")
-	msgpack.NewEncoder(w).Encode(categories)
-}
-
-func getCategoryByID(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/x-msgpack")
+// MessagePack por padrão; o Accept pode pedir qualquer outro formato
+var formats = negotiate.Offer(negotiate.MsgPack)
 
-	vars := mux.Vars(r)
-	idStr := vars["categoryId"]
-
-	id, err := strconv.Atoi(idStr)
+func main() {
+	cfg, err := dataset.Load()
 	if err != nil {
-		http.Error(w, "ID inválido", http.StatusBadRequest)
-		return
+		log.Fatal(err)
 	}
-
-	for _, cat := range categories {
-		if cat.ID == id {
-			msgpack.NewEncoder(w).Encode(cat)
-			return
-		}
+	r, err := httpapi.Categories(cfg.Size, formats)
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	http.Error(w, "Categoria não encontrada", http.StatusNotFound)
-}
-
-func main() {
-	r := mux.NewRouter()
-	r.HandleFunc("/categories", getAllCategories).Methods("GET")
-	r.HandleFunc("/categories/{categoryId}", getCategoryByID).Methods("GET")
-
 	http.ListenAndServe(":8080", r)
 }
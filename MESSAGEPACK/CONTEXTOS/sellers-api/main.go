@@ -1,62 +1,26 @@
 package main
 
 import (
-	"bytes"
+	"log"
 	"net/http"
-	"strconv"
 
-	"github.com/gorilla/mux"
-	"github.com/vmihailenco/msgpack/v5"
+	"shared/dataset"
+	"shared/httpapi"
+	"shared/negotiate"
 )
 
-type Seller struct {
-	ID   int    `msgpack:"id"`
-	Name string `msgpack:"name"`
-}
-
-var sellers = []Seller{}
-
-func init() {
-	for i := 1; i <= 100; i++ {
-		sellers = append(sellers, Seller{
-			ID:   i,
-			Name: "Seller " + strconv.Itoa(i),
-		})
-	}
-}
+// MessagePack por padrão; o Accept pode pedir qualquer outro formato
+var formats = negotiate.Offer(negotiate.MsgPack)
 
-func getAllSellers(w http.ResponseWriter, r *http.Request) {
-	var buf bytes.Buffer
-	enc := msgpack.NewEncoder(&buf)
-	_ = enc.Encode(sellers)
-	w.Header().Set("Content-Type", "application/x-msgpack")
-	w.Write(buf.Bytes())
-}
-
-func getSellerByID(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	idStr := vars["sellerId"]
-	id, err := strconv.Atoi(idStr)
+func main() {
+	cfg, err := dataset.Load()
 	if err != nil {
-		http.Error(w, "ID inválido", http.StatusBadRequest)
-		return
+		log.Fatal(err)
 	}
-	for _, s := range sellers {
-		if s.ID == id {
-			var buf bytes.Buffer
-			enc := msgpack.NewEncoder(&buf)
-			_ = enc.Encode(s)
-			w.Header().Set("Content-Type", "application/x-msgpack")
-			w.Write(buf.Bytes())
-			return
-		}
+	r, err := httpapi.Sellers(cfg.Size, formats)
+	if err != nil {
+		log.Fatal(err)
 	}
-	http.Error(w, "Vendedor não encontrado", http.StatusNotFound)
-}
 
-func main() {
-	r := mux.NewRouter()
-	r.HandleFunc("/sellers", getAllSellers).Methods("GET")
-	r.HandleFunc("/sellers/{sellerId}", getSellerByID).Methods("GET")
 	http.ListenAndServe(":8080", r)
 }
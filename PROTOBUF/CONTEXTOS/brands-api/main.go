@@ -0,0 +1,26 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"shared/dataset"
+	"shared/httpapi"
+	"shared/negotiate"
+)
+
+// Protobuf por padrão; o Accept pode pedir qualquer outro formato
+var formats = negotiate.Offer(negotiate.Protobuf)
+
+func main() {
+	cfg, err := dataset.Load()
+	if err != nil {
+		log.Fatal(err)
+	}
+	r, err := httpapi.Brands(cfg.Size, formats)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	http.ListenAndServe(":8080", r)
+}
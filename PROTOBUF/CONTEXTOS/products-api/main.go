@@ -0,0 +1,32 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"shared/dataset"
+	"shared/enrich"
+	"shared/httpapi"
+	"shared/negotiate"
+)
+
+// Protobuf por padrão; o Accept pode pedir qualquer outro formato
+var formats = negotiate.Offer(negotiate.Protobuf)
+
+func main() {
+	cfg, err := dataset.Load()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var endpoints *enrich.Endpoints
+	if e, ok := enrich.EndpointsFromEnv(); ok {
+		endpoints = &e
+	}
+	r, err := httpapi.Products(cfg, formats, endpoints)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	http.ListenAndServe(":8080", r)
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// breakingRule compara a versão anterior de um arquivo com a atual; current
+// é nil quando o arquivo foi removido
+type breakingRule struct {
+	id    string
+	check func(previous, current protoreflect.FileDescriptor, r reporter)
+}
+
+func (r breakingRule) ruleID() string { return r.id }
+
+// breakingRules segue os IDs das regras da categoria FILE do buf, restritas
+// ao que os .proto do repositório usam (sem enums, oneofs ou extensões)
+var breakingRules = []breakingRule{
+	{"FILE_NO_DELETE", func(previous, current protoreflect.FileDescriptor, r reporter) {
+		if current == nil {
+			r.at(protoreflect.SourceLocation{}, "arquivo %q foi removido", previous.Path())
+		}
+	}},
+	{"FILE_SAME_PACKAGE", func(previous, current protoreflect.FileDescriptor, r reporter) {
+		if current != nil && previous.Package() != current.Package() {
+			r.at(current.SourceLocations().ByPath(protoreflect.SourcePath{packagePath}), "package mudou de %q para %q", previous.Package(), current.Package())
+		}
+	}},
+	{"FILE_SAME_GO_PACKAGE", func(previous, current protoreflect.FileDescriptor, r reporter) {
+		if current != nil && goPackage(previous) != goPackage(current) {
+			r.at(protoreflect.SourceLocation{}, "go_package mudou de %q para %q", goPackage(previous), goPackage(current))
+		}
+	}},
+	{"MESSAGE_NO_DELETE", eachMessage(func(previous, current protoreflect.MessageDescriptor, r reporter) {
+		if current == nil {
+			r.at(protoreflect.SourceLocation{}, "message %q foi removida", previous.FullName())
+		}
+	})},
+	{"FIELD_NO_DELETE", eachField(func(previous, current protoreflect.FieldDescriptor, parent protoreflect.MessageDescriptor, r reporter) {
+		if current == nil {
+			r.descriptor(parent, "campo %d (%s) foi removido de %q", previous.Number(), previous.Name(), parent.FullName())
+		}
+	})},
+	{"FIELD_SAME_NAME", eachField(func(previous, current protoreflect.FieldDescriptor, _ protoreflect.MessageDescriptor, r reporter) {
+		if current != nil && previous.Name() != current.Name() {
+			r.descriptor(current, "campo %d mudou de nome de %q para %q", current.Number(), previous.Name(), current.Name())
+		}
+	})},
+	{"FIELD_SAME_TYPE", eachField(func(previous, current protoreflect.FieldDescriptor, _ protoreflect.MessageDescriptor, r reporter) {
+		if current != nil && fieldType(previous) != fieldType(current) {
+			r.descriptor(current, "campo %d (%s) mudou de tipo de %s para %s", current.Number(), current.Name(), fieldType(previous), fieldType(current))
+		}
+	})},
+	{"FIELD_SAME_LABEL", eachField(func(previous, current protoreflect.FieldDescriptor, _ protoreflect.MessageDescriptor, r reporter) {
+		if current != nil && previous.Cardinality() != current.Cardinality() {
+			r.descriptor(current, "campo %d (%s) mudou de %s para %s", current.Number(), current.Name(), previous.Cardinality(), current.Cardinality())
+		}
+	})},
+	{"SERVICE_NO_DELETE", eachService(func(previous, current protoreflect.ServiceDescriptor, r reporter) {
+		if current == nil {
+			r.at(protoreflect.SourceLocation{}, "service %q foi removido", previous.FullName())
+		}
+	})},
+	{"RPC_NO_DELETE", eachMethod(func(previous, current protoreflect.MethodDescriptor, parent protoreflect.ServiceDescriptor, r reporter) {
+		if current == nil {
+			r.descriptor(parent, "rpc %q foi removida de %q", previous.Name(), parent.FullName())
+		}
+	})},
+	{"RPC_SAME_REQUEST_TYPE", eachMethod(func(previous, current protoreflect.MethodDescriptor, _ protoreflect.ServiceDescriptor, r reporter) {
+		if current != nil && previous.Input().FullName() != current.Input().FullName() {
+			r.descriptor(current, "rpc %q mudou o pedido de %s para %s", current.Name(), previous.Input().FullName(), current.Input().FullName())
+		}
+	})},
+	{"RPC_SAME_RESPONSE_TYPE", eachMethod(func(previous, current protoreflect.MethodDescriptor, _ protoreflect.ServiceDescriptor, r reporter) {
+		if current != nil && previous.Output().FullName() != current.Output().FullName() {
+			r.descriptor(current, "rpc %q mudou a resposta de %s para %s", current.Name(), previous.Output().FullName(), current.Output().FullName())
+		}
+	})},
+	{"RPC_SAME_CLIENT_STREAMING", eachMethod(func(previous, current protoreflect.MethodDescriptor, _ protoreflect.ServiceDescriptor, r reporter) {
+		if current != nil && previous.IsStreamingClient() != current.IsStreamingClient() {
+			r.descriptor(current, "rpc %q mudou o streaming do cliente para %t", current.Name(), current.IsStreamingClient())
+		}
+	})},
+	{"RPC_SAME_SERVER_STREAMING", eachMethod(func(previous, current protoreflect.MethodDescriptor, _ protoreflect.ServiceDescriptor, r reporter) {
+		if current != nil && previous.IsStreamingServer() != current.IsStreamingServer() {
+			r.descriptor(current, "rpc %q mudou o streaming do servidor para %t", current.Name(), current.IsStreamingServer())
+		}
+	})},
+}
+
+func goPackage(fd protoreflect.FileDescriptor) string {
+	options, _ := fd.Options().(*descriptorpb.FileOptions)
+	return options.GetGoPackage()
+}
+
+// fieldType descreve o tipo do campo com o nome da message ou enum, quando há
+func fieldType(f protoreflect.FieldDescriptor) string {
+	switch {
+	case f.Message() != nil:
+		return string(f.Message().FullName())
+	case f.Enum() != nil:
+		return string(f.Enum().FullName())
+	}
+	return f.Kind().String()
+}
+
+// eachMessage aplica check às mensagens da versão anterior de um arquivo,
+// pareadas pelo nome completo com as da atual
+func eachMessage(check func(previous, current protoreflect.MessageDescriptor, r reporter)) func(previous, current protoreflect.FileDescriptor, r reporter) {
+	return func(previous, current protoreflect.FileDescriptor, r reporter) {
+		if current == nil {
+			return
+		}
+		byName := map[protoreflect.FullName]protoreflect.MessageDescriptor{}
+		for _, m := range messages(current.Messages()) {
+			byName[m.FullName()] = m
+		}
+		for _, m := range messages(previous.Messages()) {
+			check(m, byName[m.FullName()], r)
+		}
+	}
+}
+
+// eachField aplica check aos campos de cada message mantida, pareados pelo
+// número
+func eachField(check func(previous, current protoreflect.FieldDescriptor, parent protoreflect.MessageDescriptor, r reporter)) func(previous, current protoreflect.FileDescriptor, r reporter) {
+	return eachMessage(func(previous, current protoreflect.MessageDescriptor, r reporter) {
+		if current == nil {
+			return
+		}
+		fields := previous.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			check(fields.Get(i), current.Fields().ByNumber(fields.Get(i).Number()), current, r)
+		}
+	})
+}
+
+func eachService(check func(previous, current protoreflect.ServiceDescriptor, r reporter)) func(previous, current protoreflect.FileDescriptor, r reporter) {
+	return func(previous, current protoreflect.FileDescriptor, r reporter) {
+		if current == nil {
+			return
+		}
+		services := previous.Services()
+		for i := 0; i < services.Len(); i++ {
+			check(services.Get(i), current.Services().ByName(services.Get(i).Name()), r)
+		}
+	}
+}
+
+func eachMethod(check func(previous, current protoreflect.MethodDescriptor, parent protoreflect.ServiceDescriptor, r reporter)) func(previous, current protoreflect.FileDescriptor, r reporter) {
+	return eachService(func(previous, current protoreflect.ServiceDescriptor, r reporter) {
+		if current == nil {
+			return
+		}
+		methods := previous.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			check(methods.Get(i), current.Methods().ByName(methods.Get(i).Name()), current, r)
+		}
+	})
+}
+
+// breaking compara cada arquivo de previous com o de mesmo caminho em current;
+// as violações apontam para o arquivo atual, ou para o anterior se ele sumiu
+func breaking(previous, current []protoreflect.FileDescriptor, rules []breakingRule) []violation {
+	byPath := map[string]protoreflect.FileDescriptor{}
+	for _, fd := range current {
+		byPath[fd.Path()] = fd
+	}
+
+	var violations []violation
+	for _, old := range previous {
+		fd := byPath[old.Path()]
+		target := fd
+		if target == nil {
+			target = old
+		}
+		for _, rule := range rules {
+			rule.check(old, fd, reporter{fd: target, rule: rule.id, violations: &violations})
+		}
+	}
+	sortViolations(violations)
+	return violations
+}
@@ -0,0 +1,100 @@
+package main
+
+import "testing"
+
+const brandV1 = `syntax = "proto3";
+
+package proto;
+
+option go_package = "./proto/brand;brandpb";
+
+message Brand {
+  int32 id = 1;
+  string name = 2;
+  repeated string tags = 3;
+}
+
+message BrandRequest {
+  int32 id = 1;
+}
+
+message Legacy {
+  int32 id = 1;
+}
+
+service BrandService {
+  rpc GetBrandByID (BrandRequest) returns (Brand);
+  rpc StreamBrands (BrandRequest) returns (stream Brand);
+  rpc Remove (BrandRequest) returns (Brand);
+}
+
+service LegacyService {
+  rpc Get (BrandRequest) returns (Legacy);
+}
+`
+
+const brandV2 = `syntax = "proto3";
+
+package proto;
+
+option go_package = "./proto/brand;brandpb";
+
+message Brand {
+  int32 id = 1;
+  int64 name = 2;
+  string tags = 3;
+  string country = 4;
+}
+
+message BrandRequest {
+  int32 brand_id = 1;
+}
+
+service BrandService {
+  rpc GetBrandByID (BrandRequest) returns (BrandRequest);
+  rpc StreamBrands (stream BrandRequest) returns (Brand);
+}
+`
+
+// O arquivo é comparado pelo caminho: package, go_package e a remoção
+func TestBreakingFiles(t *testing.T) {
+	previous := compileSource(t, map[string]string{
+		"proto/brand/brand.proto": "syntax = \"proto3\";\n\npackage proto;\n\noption go_package = \"./proto/brand;brandpb\";\n",
+		"proto/old/old.proto":     "syntax = \"proto3\";\n\npackage proto;\n",
+	})
+	current := compileSource(t, map[string]string{
+		"proto/brand/brand.proto": "syntax = \"proto3\";\n\npackage proto.v2;\n\noption go_package = \"./proto/brand/v2;brandpb\";\n",
+	})
+
+	checkViolations(t, breaking(previous, current, breakingRules), []string{
+		"proto/brand/brand.proto:1:1: FILE_SAME_GO_PACKAGE",
+		"proto/brand/brand.proto:3:1: FILE_SAME_PACKAGE",
+		"proto/old/old.proto:1:1: FILE_NO_DELETE",
+	})
+}
+
+func TestBreaking(t *testing.T) {
+	previous := compileSource(t, map[string]string{"proto/brand/brand.proto": brandV1})
+	current := compileSource(t, map[string]string{"proto/brand/brand.proto": brandV2})
+
+	checkViolations(t, breaking(previous, current, breakingRules), []string{
+		"proto/brand/brand.proto:1:1: MESSAGE_NO_DELETE",
+		"proto/brand/brand.proto:1:1: SERVICE_NO_DELETE",
+		"proto/brand/brand.proto:9:3: FIELD_SAME_TYPE",
+		"proto/brand/brand.proto:10:3: FIELD_SAME_LABEL",
+		"proto/brand/brand.proto:15:3: FIELD_SAME_NAME",
+		"proto/brand/brand.proto:18:1: RPC_NO_DELETE",
+		"proto/brand/brand.proto:19:3: RPC_SAME_RESPONSE_TYPE",
+		"proto/brand/brand.proto:20:3: RPC_SAME_CLIENT_STREAMING",
+		"proto/brand/brand.proto:20:3: RPC_SAME_SERVER_STREAMING",
+	})
+}
+
+func TestBreakingUnchanged(t *testing.T) {
+	previous := compileSource(t, map[string]string{"proto/brand/brand.proto": brandV1})
+	current := compileSource(t, map[string]string{"proto/brand/brand.proto": brandV1})
+
+	for _, v := range breaking(previous, current, breakingRules) {
+		t.Error(v)
+	}
+}
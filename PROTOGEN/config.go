@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// config é o subconjunto do buf.yaml (version v1) que o protogen entende:
+// as listas use e except de lint e breaking, com IDs de regra do buf
+type config struct {
+	Version  string    `yaml:"version"`
+	Lint     ruleNames `yaml:"lint"`
+	Breaking ruleNames `yaml:"breaking"`
+}
+
+type ruleNames struct {
+	Use    []string `yaml:"use"`
+	Except []string `yaml:"except"`
+}
+
+// loadConfig lê o buf.yaml em path; sem o arquivo valem todas as regras
+func loadConfig(path string) (config, error) {
+	var cfg config
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, err
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("%s: %w", path, err)
+	}
+	if cfg.Version != "" && cfg.Version != "v1" {
+		return cfg, fmt.Errorf("%s: version %q não suportada, use v1", path, cfg.Version)
+	}
+	return cfg, nil
+}
+
+// selectRules filtra all pelas listas do buf.yaml, na ordem de all; use vazio
+// equivale a todas as regras
+func selectRules[R any](all []R, id func(R) string, names ruleNames) ([]R, error) {
+	known := map[string]bool{}
+	for _, r := range all {
+		known[id(r)] = true
+	}
+	for _, name := range append(slices.Clone(names.Use), names.Except...) {
+		if !known[name] {
+			return nil, fmt.Errorf("regra desconhecida %s", name)
+		}
+	}
+
+	var selected []R
+	for _, r := range all {
+		if len(names.Use) > 0 && !slices.Contains(names.Use, id(r)) {
+			continue
+		}
+		if slices.Contains(names.Except, id(r)) {
+			continue
+		}
+		selected = append(selected, r)
+	}
+	return selected, nil
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// violation é uma infração de lint ou breaking, no formato dos erros do buf
+type violation struct {
+	file      string
+	line, col int
+	rule      string
+	message   string
+}
+
+func (v violation) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s %s", v.file, v.line, v.col, v.rule, v.message)
+}
+
+func sortViolations(violations []violation) {
+	sort.SliceStable(violations, func(i, j int) bool {
+		a, b := violations[i], violations[j]
+		if a.file != b.file {
+			return a.file < b.file
+		}
+		if a.line != b.line {
+			return a.line < b.line
+		}
+		return a.col < b.col
+	})
+}
+
+// reporter acumula as violações de uma regra em um arquivo
+type reporter struct {
+	fd         protoreflect.FileDescriptor
+	rule       string
+	violations *[]violation
+}
+
+func (r reporter) at(loc protoreflect.SourceLocation, format string, args ...any) {
+	*r.violations = append(*r.violations, violation{
+		file:    r.fd.Path(),
+		line:    loc.StartLine + 1,
+		col:     loc.StartColumn + 1,
+		rule:    r.rule,
+		message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (r reporter) descriptor(d protoreflect.Descriptor, format string, args ...any) {
+	r.at(r.fd.SourceLocations().ByDescriptor(d), format, args...)
+}
+
+// Caminhos de FileDescriptorProto usados para localizar o package e os imports
+const (
+	packagePath    = 2
+	dependencyPath = 3
+)
+
+type lintRule struct {
+	id    string
+	check func(fd protoreflect.FileDescriptor, r reporter)
+}
+
+func (r lintRule) ruleID() string { return r.id }
+
+// lintRules segue os IDs e o significado das regras de mesmo nome do buf
+var lintRules = []lintRule{
+	{"PACKAGE_DEFINED", func(fd protoreflect.FileDescriptor, r reporter) {
+		if fd.Package() == "" {
+			r.at(protoreflect.SourceLocation{}, "arquivo sem package")
+		}
+	}},
+	{"PACKAGE_LOWER_SNAKE_CASE", func(fd protoreflect.FileDescriptor, r reporter) {
+		if fd.Package() == "" {
+			return
+		}
+		for _, part := range strings.Split(string(fd.Package()), ".") {
+			if !lowerSnakeCase.MatchString(part) {
+				r.at(fd.SourceLocations().ByPath(protoreflect.SourcePath{packagePath}), "package %q deve ser lower_snake_case", fd.Package())
+				return
+			}
+		}
+	}},
+	{"FILE_LOWER_SNAKE_CASE", func(fd protoreflect.FileDescriptor, r reporter) {
+		if name := strings.TrimSuffix(path.Base(fd.Path()), ".proto"); !lowerSnakeCase.MatchString(name) {
+			r.at(protoreflect.SourceLocation{}, "nome de arquivo %q deve ser lower_snake_case", path.Base(fd.Path()))
+		}
+	}},
+	{"IMPORT_USED", checkImportUsed},
+	{"MESSAGE_PASCAL_CASE", func(fd protoreflect.FileDescriptor, r reporter) {
+		for _, m := range messages(fd.Messages()) {
+			if !pascalCase.MatchString(string(m.Name())) {
+				r.descriptor(m, "message %q deve ser PascalCase", m.Name())
+			}
+		}
+	}},
+	{"FIELD_LOWER_SNAKE_CASE", func(fd protoreflect.FileDescriptor, r reporter) {
+		for _, m := range messages(fd.Messages()) {
+			for i := 0; i < m.Fields().Len(); i++ {
+				if f := m.Fields().Get(i); !lowerSnakeCase.MatchString(string(f.Name())) {
+					r.descriptor(f, "campo %s.%s deve ser lower_snake_case", m.Name(), f.Name())
+				}
+			}
+		}
+	}},
+	{"SERVICE_PASCAL_CASE", func(fd protoreflect.FileDescriptor, r reporter) {
+		for i := 0; i < fd.Services().Len(); i++ {
+			if s := fd.Services().Get(i); !pascalCase.MatchString(string(s.Name())) {
+				r.descriptor(s, "service %q deve ser PascalCase", s.Name())
+			}
+		}
+	}},
+	{"SERVICE_SUFFIX", func(fd protoreflect.FileDescriptor, r reporter) {
+		for i := 0; i < fd.Services().Len(); i++ {
+			if s := fd.Services().Get(i); !strings.HasSuffix(string(s.Name()), "Service") {
+				r.descriptor(s, "service %q deve terminar em Service", s.Name())
+			}
+		}
+	}},
+	{"RPC_PASCAL_CASE", func(fd protoreflect.FileDescriptor, r reporter) {
+		for i := 0; i < fd.Services().Len(); i++ {
+			methods := fd.Services().Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				if m := methods.Get(j); !pascalCase.MatchString(string(m.Name())) {
+					r.descriptor(m, "rpc %q deve ser PascalCase", m.Name())
+				}
+			}
+		}
+	}},
+}
+
+var (
+	lowerSnakeCase = regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)
+	pascalCase     = regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)
+)
+
+// checkImportUsed aponta os imports que nenhum campo ou rpc do arquivo usa
+func checkImportUsed(fd protoreflect.FileDescriptor, r reporter) {
+	used := map[string]bool{}
+	for _, m := range messages(fd.Messages()) {
+		for i := 0; i < m.Fields().Len(); i++ {
+			f := m.Fields().Get(i)
+			if f.Message() != nil {
+				used[f.Message().ParentFile().Path()] = true
+			}
+			if f.Enum() != nil {
+				used[f.Enum().ParentFile().Path()] = true
+			}
+		}
+	}
+	for i := 0; i < fd.Services().Len(); i++ {
+		methods := fd.Services().Get(i).Methods()
+		for j := 0; j < methods.Len(); j++ {
+			used[methods.Get(j).Input().ParentFile().Path()] = true
+			used[methods.Get(j).Output().ParentFile().Path()] = true
+		}
+	}
+
+	imports := fd.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		imp := imports.Get(i)
+		if !imp.IsPublic && !used[imp.Path()] {
+			r.at(fd.SourceLocations().ByPath(protoreflect.SourcePath{dependencyPath, int32(i)}), "import %q não é usado", imp.Path())
+		}
+	}
+}
+
+// messages devolve as mensagens e as aninhadas, sem as entradas sintéticas
+// dos campos map
+func messages(list protoreflect.MessageDescriptors) []protoreflect.MessageDescriptor {
+	var result []protoreflect.MessageDescriptor
+	for i := 0; i < list.Len(); i++ {
+		m := list.Get(i)
+		if m.IsMapEntry() {
+			continue
+		}
+		result = append(result, m)
+		result = append(result, messages(m.Messages())...)
+	}
+	return result
+}
+
+func lint(files []protoreflect.FileDescriptor, rules []lintRule) []violation {
+	var violations []violation
+	for _, fd := range files {
+		for _, rule := range rules {
+			rule.check(fd, reporter{fd: fd, rule: rule.id, violations: &violations})
+		}
+	}
+	sortViolations(violations)
+	return violations
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// compileSource compila os .proto em memória de files
+func compileSource(t *testing.T, files map[string]string) []protoreflect.FileDescriptor {
+	t.Helper()
+	fsys := fstest.MapFS{}
+	for name, source := range files {
+		fsys[name] = &fstest.MapFile{Data: []byte(source)}
+	}
+	names, err := filesOrAll(fsys, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compiled, err := compile(fsys, names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return compiled
+}
+
+// checkViolations confere as regras e posições das violações, na ordem
+func checkViolations(t *testing.T, got []violation, want []string) {
+	t.Helper()
+	var lines []string
+	for _, v := range got {
+		lines = append(lines, fmt.Sprintf("%s:%d:%d: %s", v.file, v.line, v.col, v.rule))
+	}
+	if strings.Join(lines, "\n") != strings.Join(want, "\n") {
+		t.Errorf("violações:\n%s\nesperado:\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestLint(t *testing.T) {
+	files := compileSource(t, map[string]string{
+		"proto/bad/BadFile.proto": `syntax = "proto3";
+
+package Proto.v1;
+
+import "google/protobuf/empty.proto";
+import "google/protobuf/timestamp.proto";
+
+message good_message {
+  int32 id = 1;
+  string fullName = 2;
+  message inner_thing {
+    int32 ok_field = 1;
+  }
+}
+
+service Brands {
+  rpc get_brand (google.protobuf.Empty) returns (good_message);
+}
+`,
+		"proto/good/good.proto": `syntax = "proto3";
+
+package proto;
+
+import "proto/good/dep.proto";
+
+message Good {
+  Dep dep = 1;
+  map<string, Dep> by_name = 2;
+}
+
+service GoodService {
+  rpc GetGood (Dep) returns (Good);
+}
+`,
+		"proto/good/dep.proto": `syntax = "proto3";
+
+message Dep {
+  int32 id = 1;
+}
+`,
+	})
+
+	checkViolations(t, lint(files, lintRules), []string{
+		"proto/bad/BadFile.proto:1:1: FILE_LOWER_SNAKE_CASE",
+		"proto/bad/BadFile.proto:3:1: PACKAGE_LOWER_SNAKE_CASE",
+		"proto/bad/BadFile.proto:6:1: IMPORT_USED",
+		"proto/bad/BadFile.proto:8:1: MESSAGE_PASCAL_CASE",
+		"proto/bad/BadFile.proto:10:3: FIELD_LOWER_SNAKE_CASE",
+		"proto/bad/BadFile.proto:11:3: MESSAGE_PASCAL_CASE",
+		"proto/bad/BadFile.proto:16:1: SERVICE_SUFFIX",
+		"proto/bad/BadFile.proto:17:3: RPC_PASCAL_CASE",
+		"proto/good/dep.proto:1:1: PACKAGE_DEFINED",
+	})
+}
+
+func TestLintSelectedRules(t *testing.T) {
+	files := compileSource(t, map[string]string{
+		"a.proto": `syntax = "proto3";
+
+message a {
+  int32 Id = 1;
+}
+`,
+	})
+
+	rules, err := selectRules(lintRules, lintRule.ruleID, ruleNames{
+		Use:    []string{"PACKAGE_DEFINED", "MESSAGE_PASCAL_CASE", "FIELD_LOWER_SNAKE_CASE"},
+		Except: []string{"PACKAGE_DEFINED"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkViolations(t, lint(files, rules), []string{
+		"a.proto:3:1: MESSAGE_PASCAL_CASE",
+		"a.proto:4:3: FIELD_LOWER_SNAKE_CASE",
+	})
+
+	if _, err := selectRules(lintRules, lintRule.ruleID, ruleNames{Use: []string{"ENUM_ZERO_VALUE_SUFFIX"}}); err == nil {
+		t.Error("regra desconhecida aceita")
+	}
+}
+
+// Os contratos compartilhados passam nas regras do próprio buf.yaml
+func TestLintShared(t *testing.T) {
+	cfg, err := loadConfig("../SHARED/buf.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Lint.Use) == 0 || len(cfg.Breaking.Use) == 0 {
+		t.Fatal("buf.yaml sem regras de lint ou breaking")
+	}
+	rules, err := selectRules(lintRules, lintRule.ruleID, cfg.Lint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := selectRules(breakingRules, breakingRule.ruleID, cfg.Breaking); err != nil {
+		t.Fatal(err)
+	}
+
+	compiled := compileShared(t)
+	for _, v := range lint(compiled, rules) {
+		t.Error(v)
+	}
+}
@@ -0,0 +1,329 @@
+// protogen gera o código Go dos .proto sem depender do protoc instalado: os
+// arquivos são compilados com protocompile e o CodeGeneratorRequest é
+// entregue aos plugins protoc-gen-go e protoc-gen-go-grpc declarados como
+// tool no go.mod. Os contratos ficam só em SHARED/proto, de onde saem os
+// pacotes usados pelo BFF e pelos contextos; rode a partir deste diretório:
+//
+//	go run . -I ../SHARED
+//
+// equivale a protoc --go_out=. --go-grpc_out=. proto/*/*.proto rodado em
+// SHARED. Sem arquivos na linha de comando valem todos os .proto de -I.
+//
+// Os subcomandos lint e breaking aplicam as regras do buf listadas no
+// buf.yaml de -I, também sem rede:
+//
+//	go run . lint -I ../SHARED
+//	go run . breaking -I ../SHARED -against HEAD
+//
+// -against aceita um diretório ou uma revisão do git; as violações saem no
+// formato arquivo:linha:coluna: REGRA mensagem e o código de saída é 1.
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing/fstest"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "lint":
+			os.Exit(runLint(os.Args[2:]))
+		case "breaking":
+			os.Exit(runBreaking(os.Args[2:]))
+		}
+	}
+	runGenerate(os.Args[1:])
+}
+
+func runGenerate(args []string) {
+	flags := flag.NewFlagSet("protogen", flag.ExitOnError)
+	importDir := flags.String("I", ".", "diretório base dos .proto; as saídas também são gravadas nele")
+	plugins := flags.String("plugins", "go,go-grpc", "plugins protoc-gen-* executados, separados por vírgula")
+	flags.Parse(args)
+
+	fsys := os.DirFS(*importDir)
+	files, err := filesOrAll(fsys, flags.Args())
+	if err != nil {
+		log.Fatal(err)
+	}
+	compiled, err := compile(fsys, files)
+	if err != nil {
+		log.Fatal(err)
+	}
+	module, err := moduleName(*importDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	req := request(compiled, files)
+	req.Parameter = proto.String(parameter(req, module))
+	for _, plugin := range strings.Split(*plugins, ",") {
+		if err := generate(plugin, req, *importDir); err != nil {
+			log.Fatalf("protoc-gen-%s: %v", plugin, err)
+		}
+	}
+}
+
+// runLint imprime as violações das regras de lint do buf.yaml e devolve o
+// código de saída: 1 se houver alguma
+func runLint(args []string) int {
+	flags := flag.NewFlagSet("protogen lint", flag.ExitOnError)
+	importDir := flags.String("I", ".", "diretório base dos .proto")
+	configPath := flags.String("config", "", "buf.yaml com as regras (padrão: buf.yaml em -I)")
+	flags.Parse(args)
+
+	cfg, err := loadConfig(configFile(*importDir, *configPath))
+	if err != nil {
+		log.Fatal(err)
+	}
+	rules, err := selectRules(lintRules, lintRule.ruleID, cfg.Lint)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fsys := os.DirFS(*importDir)
+	files, err := filesOrAll(fsys, flags.Args())
+	if err != nil {
+		log.Fatal(err)
+	}
+	compiled, err := compile(fsys, files)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return report(lint(compiled, rules))
+}
+
+// runBreaking compara os .proto de -I com os de -against, que pode ser um
+// diretório ou uma revisão do git (HEAD, main, uma tag), sem acesso à rede
+func runBreaking(args []string) int {
+	flags := flag.NewFlagSet("protogen breaking", flag.ExitOnError)
+	importDir := flags.String("I", ".", "diretório base dos .proto")
+	configPath := flags.String("config", "", "buf.yaml com as regras (padrão: buf.yaml em -I)")
+	against := flags.String("against", "", "diretório ou revisão do git com a versão anterior dos .proto de -I")
+	flags.Parse(args)
+	if *against == "" {
+		log.Fatal("informe -against")
+	}
+
+	cfg, err := loadConfig(configFile(*importDir, *configPath))
+	if err != nil {
+		log.Fatal(err)
+	}
+	rules, err := selectRules(breakingRules, breakingRule.ruleID, cfg.Breaking)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	previousFS, err := againstFS(*importDir, *against)
+	if err != nil {
+		log.Fatal(err)
+	}
+	previousFiles, err := filesOrAll(previousFS, flags.Args())
+	if err != nil {
+		log.Fatal(err)
+	}
+	previous, err := compile(previousFS, previousFiles)
+	if err != nil {
+		log.Fatalf("%s: %v", *against, err)
+	}
+
+	fsys := os.DirFS(*importDir)
+	files, err := filesOrAll(fsys, flags.Args())
+	if err != nil {
+		log.Fatal(err)
+	}
+	current, err := compile(fsys, files)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return report(breaking(previous, current, rules))
+}
+
+func configFile(importDir, configPath string) string {
+	if configPath != "" {
+		return configPath
+	}
+	return filepath.Join(importDir, "buf.yaml")
+}
+
+func report(violations []violation) int {
+	for _, v := range violations {
+		fmt.Println(v)
+	}
+	if len(violations) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// filesOrAll devolve files ou, se vazio, todos os .proto de fsys
+func filesOrAll(fsys fs.FS, files []string) ([]string, error) {
+	if len(files) > 0 {
+		return files, nil
+	}
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && path.Ext(p) == ".proto" {
+			files = append(files, p)
+		}
+		return err
+	})
+	if err == nil && len(files) == 0 {
+		err = fmt.Errorf("nenhum .proto encontrado")
+	}
+	return files, err
+}
+
+// againstFS abre a versão anterior dos .proto: o próprio diretório, se
+// against existir, ou os arquivos de dir na revisão against lidos com git show
+func againstFS(dir, against string) (fs.FS, error) {
+	if info, err := os.Stat(against); err == nil && info.IsDir() {
+		return os.DirFS(against), nil
+	}
+
+	out, err := exec.Command("git", "-C", dir, "ls-tree", "-r", "--name-only", against, "--", ".").Output()
+	if err != nil {
+		return nil, fmt.Errorf("git ls-tree %s: %w", against, err)
+	}
+	files := fstest.MapFS{}
+	for _, name := range strings.Fields(string(out)) {
+		if path.Ext(name) != ".proto" {
+			continue
+		}
+		data, err := exec.Command("git", "-C", dir, "show", against+":./"+name).Output()
+		if err != nil {
+			return nil, fmt.Errorf("git show %s:%s: %w", against, name, err)
+		}
+		files[name] = &fstest.MapFile{Data: data}
+	}
+	return files, nil
+}
+
+// compile compila files, relativos à raiz de fsys, com os imports padrão do
+// protobuf disponíveis
+func compile(fsys fs.FS, files []string) ([]protoreflect.FileDescriptor, error) {
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: func(name string) (io.ReadCloser, error) {
+				return fsys.Open(name)
+			},
+		}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	compiled, err := compiler.Compile(context.Background(), files...)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]protoreflect.FileDescriptor, len(compiled))
+	for i, fd := range compiled {
+		result[i] = fd
+	}
+	return result, nil
+}
+
+func request(compiled []protoreflect.FileDescriptor, files []string) *pluginpb.CodeGeneratorRequest {
+	req := &pluginpb.CodeGeneratorRequest{FileToGenerate: files}
+	// os plugins esperam as dependências antes de quem as importa
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		req.ProtoFile = append(req.ProtoFile, protodesc.ToFileDescriptorProto(fd))
+	}
+	for _, fd := range compiled {
+		add(fd)
+	}
+	return req
+}
+
+// moduleName lê o módulo do go.mod de -I, usado para transformar os
+// go_package relativos ("./proto/brand;brandpb") em import paths
+func moduleName(dir string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if name, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+			return strings.TrimSpace(name), nil
+		}
+	}
+	return "", fmt.Errorf("%s/go.mod sem a diretiva module", dir)
+}
+
+// parameter monta as opções M de cada arquivo com go_package relativo e o
+// module=, que tira o prefixo do módulo dos caminhos gerados
+func parameter(req *pluginpb.CodeGeneratorRequest, module string) string {
+	params := []string{"module=" + module}
+	for _, f := range req.ProtoFile {
+		goPackage := f.GetOptions().GetGoPackage()
+		dir, name, _ := strings.Cut(goPackage, ";")
+		if !strings.HasPrefix(dir, "./") {
+			continue
+		}
+		importPath := path.Join(module, dir)
+		if name != "" {
+			importPath += ";" + name
+		}
+		params = append(params, "M"+f.GetName()+"="+importPath)
+	}
+	return strings.Join(params, ",")
+}
+
+func generate(plugin string, req *pluginpb.CodeGeneratorRequest, outDir string) error {
+	in, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	var out, stderr bytes.Buffer
+	cmd := exec.Command("go", "tool", "protoc-gen-"+plugin)
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &out
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%w: %s", err, stderr.String())
+	}
+
+	resp := &pluginpb.CodeGeneratorResponse{}
+	if err := proto.Unmarshal(out.Bytes(), resp); err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s", resp.GetError())
+	}
+
+	for _, f := range resp.File {
+		path := filepath.Join(outDir, f.GetName())
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(f.GetContent()), 0o644); err != nil {
+			return err
+		}
+		log.Printf("gerado %s", path)
+	}
+	return nil
+}
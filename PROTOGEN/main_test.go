@@ -0,0 +1,74 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const sharedDir = "../SHARED"
+
+func compileShared(t *testing.T) []protoreflect.FileDescriptor {
+	t.Helper()
+	files, err := filesOrAll(os.DirFS(sharedDir), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compiled, err := compile(os.DirFS(sharedDir), files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return compiled
+}
+
+// O código gerado em SHARED corresponde aos .proto: quem muda um contrato
+// precisa rodar go run . -I ../SHARED e versionar o resultado
+func TestGeneratedUpToDate(t *testing.T) {
+	files, err := filesOrAll(os.DirFS(sharedDir), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	module, err := moduleName(sharedDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := request(compileShared(t), files)
+	req.Parameter = proto.String(parameter(req, module))
+
+	out := t.TempDir()
+	for _, plugin := range []string{"go", "go-grpc"} {
+		if err := generate(plugin, req, out); err != nil {
+			t.Fatalf("protoc-gen-%s: %v", plugin, err)
+		}
+	}
+
+	generated := 0
+	err = filepath.WalkDir(out, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		name, _ := filepath.Rel(out, path)
+		want, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		got, err := os.ReadFile(filepath.Join(sharedDir, name))
+		if err != nil {
+			return err
+		}
+		if string(got) != string(want) {
+			t.Errorf("%s desatualizado em relação ao .proto", name)
+		}
+		generated++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if generated != 2*len(files) {
+		t.Errorf("%d arquivos gerados para %d .proto", generated, len(files))
+	}
+}
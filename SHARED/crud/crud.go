@@ -0,0 +1,201 @@
+// Package crud atende as escritas dos contextos HTTP: POST, PUT, PATCH e
+// DELETE sobre um Repository. O corpo é lido no formato do Content-Type e a
+// resposta sai no formato negociado pelo Accept, como nas leituras.
+package crud
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"shared/negotiate"
+	"shared/repository"
+)
+
+// Entity é um tipo do domínio que sabe se validar e receber o ID do serviço
+type Entity[T any] interface {
+	Validate() error
+	WithID(id int) T
+}
+
+var ErrInvalidID = errors.New("ID inválido")
+
+// ParseID lê o ID de uma rota; só inteiros positivos são válidos
+func ParseID(value string) (int, error) {
+	id, err := strconv.Atoi(value)
+	if err != nil || id < 1 {
+		return 0, ErrInvalidID
+	}
+	return id, nil
+}
+
+// Resource são as rotas de escrita de um contexto. ID devolve o ID do item
+// da rota, ErrInvalidID ou repository.ErrNotFound; Location é o caminho de
+// um item, enviado na resposta do POST; NotFound é a mensagem do 404.
+type Resource[T Entity[T]] struct {
+	Repo     *repository.Repository[T]
+	Formats  negotiate.Formats
+	ID       func(r *http.Request) (int, error)
+	Location func(item T) string
+	NotFound string
+}
+
+// badRequest é um corpo que não decodifica ou não passa na validação
+type badRequest struct{ err error }
+
+func (e badRequest) Error() string { return e.err.Error() }
+func (e badRequest) Unwrap() error { return e.err }
+
+// Create grava o corpo com um novo ID, ignorando o ID enviado
+func (res *Resource[T]) Create(w http.ResponseWriter, r *http.Request) {
+	if !res.acceptable(w, r) {
+		return
+	}
+	f, data, err := res.Formats.ReadBody(r)
+	if err != nil {
+		res.fail(w, err)
+		return
+	}
+	item, err := decode[T](f, data)
+	if err == nil {
+		err = validate(item)
+	}
+	if err != nil {
+		res.fail(w, err)
+		return
+	}
+
+	created, err := res.Repo.Create(func(id int) T { return item.WithID(id) })
+	if err != nil {
+		res.fail(w, err)
+		return
+	}
+	w.Header().Set("Location", res.Location(created))
+	res.Formats.WriteStatus(w, r, http.StatusCreated, created)
+}
+
+// Replace troca o item da rota pelo corpo; o ID da rota prevalece
+func (res *Resource[T]) Replace(w http.ResponseWriter, r *http.Request) {
+	res.update(w, r, func(f negotiate.Format, data []byte, _ T) (T, error) {
+		return decode[T](f, data)
+	})
+}
+
+// Patch altera só os campos presentes no corpo; listas são trocadas inteiras
+func (res *Resource[T]) Patch(w http.ResponseWriter, r *http.Request) {
+	res.update(w, r, func(f negotiate.Format, data []byte, current T) (T, error) {
+		// a cópia evita que o Merge escreva nas listas do item publicado
+		encoded, err := f.Marshal(current)
+		if err != nil {
+			return current, err
+		}
+		item, err := decode[T](f, encoded)
+		if err != nil {
+			return current, err
+		}
+		if err := f.Merge(data, &item); err != nil {
+			return current, badRequest{err}
+		}
+		return item, nil
+	})
+}
+
+func (res *Resource[T]) update(w http.ResponseWriter, r *http.Request, build func(f negotiate.Format, data []byte, current T) (T, error)) {
+	if !res.acceptable(w, r) {
+		return
+	}
+	id, err := res.ID(r)
+	if err != nil {
+		res.fail(w, err)
+		return
+	}
+	f, data, err := res.Formats.ReadBody(r)
+	if err != nil {
+		res.fail(w, err)
+		return
+	}
+
+	updated, err := res.Repo.Update(id, func(current T) (T, error) {
+		item, err := build(f, data, current)
+		if err != nil {
+			return item, err
+		}
+		item = item.WithID(id)
+		return item, validate(item)
+	})
+	if err != nil {
+		res.fail(w, err)
+		return
+	}
+	res.Formats.Write(w, r, updated)
+}
+
+// Delete remove o item da rota e responde 204, sem corpo
+func (res *Resource[T]) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := res.ID(r)
+	if err != nil {
+		res.fail(w, err)
+		return
+	}
+	if _, err := res.Repo.Delete(id); err != nil {
+		res.fail(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// acceptable responde 406 antes de qualquer escrita cujo resultado não
+// poderia ser devolvido no formato pedido
+func (res *Resource[T]) acceptable(w http.ResponseWriter, r *http.Request) bool {
+	accept := r.Header.Get("Accept")
+	if _, ok := res.Formats.Negotiate(accept); !ok {
+		w.Header().Add("Vary", "Accept")
+		http.Error(w, "Formato não suportado: "+accept, http.StatusNotAcceptable)
+		return false
+	}
+	return true
+}
+
+func (res *Resource[T]) fail(w http.ResponseWriter, err error) {
+	var bad badRequest
+	switch {
+	case errors.Is(err, negotiate.ErrUnsupportedMediaType):
+		http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+	case errors.Is(err, ErrInvalidID):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.As(err, &bad):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, repository.ErrNotFound):
+		http.Error(w, res.NotFound, http.StatusNotFound)
+	case errors.Is(err, repository.ErrDuplicateSlug):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func decode[T any](f negotiate.Format, data []byte) (T, error) {
+	var item T
+	if err := f.Unmarshal(data, &item); err != nil {
+		return item, badRequest{fmt.Errorf("corpo inválido: %w", err)}
+	}
+	return item, nil
+}
+
+func validate[T Entity[T]](item T) error {
+	if err := item.Validate(); err != nil {
+		return badRequest{err}
+	}
+	return nil
+}
+
+// KeepCached descarta as respostas pré-codificadas de cache a cada escrita
+// em repo, para que as leituras nunca sirvam um item antigo; elas são
+// codificadas de novo na leitura seguinte, fora do lock do repositório
+func KeepCached[T any](repo *repository.Repository[T], cache *negotiate.Cache[int]) {
+	cache.Reload(func(id int) (any, bool) { return repo.ByID(id) }, func() any { return repo.All() })
+	repo.OnChange(func(c repository.Change[T]) {
+		cache.Invalidate(c.ID)
+	})
+}
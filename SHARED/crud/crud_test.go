@@ -0,0 +1,180 @@
+package crud
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"shared/dataset"
+	"shared/domain"
+	"shared/negotiate"
+	"shared/repository"
+)
+
+func newProducts(t *testing.T) (*Resource[domain.Product], *negotiate.Cache[int]) {
+	t.Helper()
+	formats := negotiate.Offer(negotiate.JSON)
+	cfg := dataset.DefaultConfig()
+	cfg.Size = 3
+	repo := repository.Products(dataset.Products(cfg))
+	cache, err := negotiate.Precompute(formats, repo.All(), func(p domain.Product) int { return p.ID })
+	if err != nil {
+		t.Fatal(err)
+	}
+	KeepCached(repo, cache)
+
+	res := &Resource[domain.Product]{
+		Repo:    repo,
+		Formats: formats,
+		ID: func(r *http.Request) (int, error) {
+			p, ok := repo.BySlug(strings.TrimPrefix(r.URL.Path, "/products/"))
+			if !ok {
+				return 0, repository.ErrNotFound
+			}
+			return p.ID, nil
+		},
+		Location: func(p domain.Product) string { return "/products/" + p.Slug },
+		NotFound: "Produto não encontrado",
+	}
+	return res, cache
+}
+
+func send(handler http.HandlerFunc, method, path, contentType string, body []byte) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, path, bytes.NewReader(body))
+	if contentType != "" {
+		r.Header.Set("Content-Type", contentType)
+	}
+	w := httptest.NewRecorder()
+	handler(w, r)
+	return w
+}
+
+func TestCreate(t *testing.T) {
+	res, cache := newProducts(t)
+	body := `{"id":99,"name":"Novo","slug":"novo","price":{"original":10},"seller_id":1,"brand_id":1,"categories":[1],"images":[]}`
+
+	w := send(res.Create, http.MethodPost, "/products", "application/json", []byte(body))
+	if w.Code != http.StatusCreated || w.Header().Get("Location") != "/products/novo" {
+		t.Fatalf("%d %q: %s", w.Code, w.Header().Get("Location"), w.Body)
+	}
+	created, ok := res.Repo.BySlug("novo")
+	if !ok || created.ID != 4 {
+		t.Fatalf("%+v, esperado ID 4", created)
+	}
+	// a escrita só descarta; o item novo é codificado na primeira leitura
+	read := httptest.NewRecorder()
+	cache.Write(read, httptest.NewRequest(http.MethodGet, "/products/novo", nil), created.ID, created)
+	if cache.Len() != 4 || !strings.Contains(read.Body.String(), `"slug":"novo"`) {
+		t.Errorf("cache com %d itens: %s", cache.Len(), read.Body)
+	}
+
+	w = send(res.Create, http.MethodPost, "/products", "application/json", []byte(body))
+	if w.Code != http.StatusConflict {
+		t.Errorf("slug repetido: %d, esperado 409", w.Code)
+	}
+}
+
+func TestCreateErrors(t *testing.T) {
+	res, _ := newProducts(t)
+	for name, tt := range map[string]struct {
+		contentType, accept, body string
+		want                      int
+	}{
+		"corpo inválido":        {"application/json", "", `{"name":`, http.StatusBadRequest},
+		"validação":             {"application/json", "", `{"name":"Sem slug"}`, http.StatusBadRequest},
+		"Content-Type estranho": {"text/plain", "", `{}`, http.StatusUnsupportedMediaType},
+		"Accept estranho":       {"application/json", "text/html", `{}`, http.StatusNotAcceptable},
+	} {
+		r := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(tt.body))
+		r.Header.Set("Content-Type", tt.contentType)
+		if tt.accept != "" {
+			r.Header.Set("Accept", tt.accept)
+		}
+		w := httptest.NewRecorder()
+		res.Create(w, r)
+		if w.Code != tt.want {
+			t.Errorf("%s: %d, esperado %d: %s", name, w.Code, tt.want, w.Body)
+		}
+	}
+	if res.Repo.Len() != 3 {
+		t.Errorf("%d produtos depois de escritas recusadas, esperado 3", res.Repo.Len())
+	}
+}
+
+func TestReplaceAndPatch(t *testing.T) {
+	res, cache := newProducts(t)
+	current := res.Repo.All()[1]
+	path := "/products/" + current.Slug
+
+	// o ID do corpo é ignorado em favor do da rota
+	replacement := domain.Product{ID: 50, Name: "Trocado", Slug: current.Slug, SellerID: 1, BrandID: 1, Categories: []int{1}, Images: []int{}}
+	data, _ := negotiate.MsgPack.Marshal(replacement)
+	w := send(res.Replace, http.MethodPut, path, "application/msgpack", data)
+	if w.Code != http.StatusOK {
+		t.Fatalf("PUT: %d %s", w.Code, w.Body)
+	}
+	want := replacement.WithID(current.ID)
+	if got, _ := res.Repo.ByID(current.ID); !reflect.DeepEqual(got, want) {
+		t.Errorf("PUT: %+v, esperado %+v", got, want)
+	}
+
+	w = send(res.Patch, http.MethodPatch, path, "", []byte(`{"name":"Alterado","categories":[2,3]}`))
+	if w.Code != http.StatusOK {
+		t.Fatalf("PATCH: %d %s", w.Code, w.Body)
+	}
+	want.Name, want.Categories = "Alterado", []int{2, 3}
+	got, _ := res.Repo.ByID(current.ID)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PATCH: %+v, esperado %+v", got, want)
+	}
+
+	cached := httptest.NewRecorder()
+	cache.Write(cached, httptest.NewRequest(http.MethodGet, path, nil), current.ID, nil)
+	if cached.Body.String() != w.Body.String() {
+		t.Errorf("cache %s, esperado %s", cached.Body, w.Body)
+	}
+
+	// um PATCH inválido não altera nada
+	w = send(res.Patch, http.MethodPatch, path, "", []byte(`{"slug":"Com Espaço"}`))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("PATCH inválido: %d, esperado 400", w.Code)
+	}
+	if after, _ := res.Repo.ByID(current.ID); !reflect.DeepEqual(after, got) {
+		t.Errorf("PATCH inválido alterou %+v", after)
+	}
+
+	w = send(res.Patch, http.MethodPatch, "/products/nao-existe", "", []byte(`{}`))
+	if w.Code != http.StatusNotFound || strings.TrimSpace(w.Body.String()) != "Produto não encontrado" {
+		t.Errorf("PATCH inexistente: %d %s", w.Code, w.Body)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	res, cache := newProducts(t)
+	p := res.Repo.All()[0]
+
+	w := send(res.Delete, http.MethodDelete, "/products/"+p.Slug, "", nil)
+	if w.Code != http.StatusNoContent || w.Body.Len() != 0 {
+		t.Fatalf("%d %s", w.Code, w.Body)
+	}
+	if _, ok := res.Repo.ByID(p.ID); ok || cache.Len() != 2 {
+		t.Errorf("produto ainda presente, cache com %d itens", cache.Len())
+	}
+
+	w = send(res.Delete, http.MethodDelete, "/products/"+p.Slug, "", nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("segundo DELETE: %d, esperado 404", w.Code)
+	}
+}
+
+func TestParseID(t *testing.T) {
+	for value, want := range map[string]int{"1": 1, "42": 42, "0": 0, "-1": 0, "abc": 0} {
+		id, err := ParseID(value)
+		if id != want || (want == 0) != (err != nil) {
+			t.Errorf("%q: %d %v, esperado %d", value, id, err, want)
+		}
+	}
+}
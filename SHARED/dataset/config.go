@@ -0,0 +1,102 @@
+package dataset
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Size é a quantidade padrão de registros de cada contexto
+const Size = 100
+
+// DefaultSeed é a seed usada quando CATALOG_SEED não é informada
+const DefaultSeed = 1
+
+// Config controla o tamanho dos contextos e o formato do catálogo de
+// produtos. Todos os serviços de uma stack precisam usar o mesmo Size para
+// que os IDs referenciados pelos produtos existam nos outros contextos.
+type Config struct {
+	Size int
+	// Seed define a sequência de preços e relacionamentos dos produtos.
+	// O gerador é o PCG de math/rand/v2, cuja saída para uma seed é
+	// estável entre versões do Go.
+	Seed uint64
+	// Categories e Images definem quantos IDs cada produto referencia
+	Categories Distribution
+	Images     Distribution
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Size:       Size,
+		Seed:       DefaultSeed,
+		Categories: Fixed(1),
+		Images:     Fixed(1),
+	}
+}
+
+// ConfigFromEnv parte de DefaultConfig e aplica CATALOG_SIZE, CATALOG_SEED,
+// CATALOG_CATEGORIES e CATALOG_IMAGES, quando definidas
+func ConfigFromEnv() (Config, error) {
+	cfg := DefaultConfig()
+	if v := os.Getenv("CATALOG_SIZE"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("CATALOG_SIZE inválido: %w", err)
+		}
+		cfg.Size = size
+	}
+	if v := os.Getenv("CATALOG_SEED"); v != "" {
+		seed, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("CATALOG_SEED inválida: %w", err)
+		}
+		cfg.Seed = seed
+	}
+	if v := os.Getenv("CATALOG_CATEGORIES"); v != "" {
+		if err := cfg.Categories.Set(v); err != nil {
+			return cfg, fmt.Errorf("CATALOG_CATEGORIES: %w", err)
+		}
+	}
+	if v := os.Getenv("CATALOG_IMAGES"); v != "" {
+		if err := cfg.Images.Set(v); err != nil {
+			return cfg, fmt.Errorf("CATALOG_IMAGES: %w", err)
+		}
+	}
+	return cfg, nil
+}
+
+// RegisterFlags registra em fs as flags equivalentes às variáveis de
+// ambiente, usando os valores atuais de c como padrão
+func (c *Config) RegisterFlags(fs *flag.FlagSet) {
+	fs.IntVar(&c.Size, "catalog-size", c.Size, "registros por contexto")
+	fs.Uint64Var(&c.Seed, "catalog-seed", c.Seed, "seed do catálogo de produtos")
+	fs.Var(&c.Categories, "catalog-categories", "categorias por produto (fixed:N, uniform:MIN-MAX ou zipf:S:MIN-MAX)")
+	fs.Var(&c.Images, "catalog-images", "imagens por produto (fixed:N, uniform:MIN-MAX ou zipf:S:MIN-MAX)")
+}
+
+func (c Config) Validate() error {
+	if c.Size < 1 {
+		return fmt.Errorf("tamanho do catálogo deve ser positivo: %d", c.Size)
+	}
+	if err := c.Categories.validate(c.Size); err != nil {
+		return fmt.Errorf("categorias por produto: %w", err)
+	}
+	if err := c.Images.validate(c.Size); err != nil {
+		return fmt.Errorf("imagens por produto: %w", err)
+	}
+	return nil
+}
+
+// Load lê a configuração do ambiente e das flags da linha de comando, que
+// têm precedência, e a valida. Deve ser chamada uma vez, no main.
+func Load() (Config, error) {
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		return cfg, err
+	}
+	cfg.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+	return cfg, cfg.Validate()
+}
@@ -0,0 +1,102 @@
+// Package dataset gera os registros de cada contexto. A geração é
+// determinística: qualquer stack que chame as mesmas funções com o mesmo
+// tamanho e a mesma Config recebe os mesmos dados, na mesma ordem.
+package dataset
+
+import (
+	"math/rand/v2"
+	"strconv"
+
+	"shared/domain"
+)
+
+var brandDescriptions = []string{
+	"Marca premium com presença global.",
+	"Referência em sustentabilidade.",
+	"Foco em design minimalista e funcional.",
+	"Marca líder em tecnologia de consumo.",
+	"Conhecida por produtos acessíveis e duráveis.",
+}
+
+var brandCountries = []string{"Brasil", "Estados Unidos", "Alemanha", "Japão"}
+
+func Brands(n int) []domain.Brand {
+	brands := make([]domain.Brand, 0, n)
+	for i := 1; i <= n; i++ {
+		brands = append(brands, domain.Brand{
+			ID:          i,
+			Name:        "Brand " + strconv.Itoa(i),
+			Description: brandDescriptions[i%len(brandDescriptions)],
+			Country:     brandCountries[i%len(brandCountries)],
+			Active:      i%2 == 0,
+		})
+	}
+	return brands
+}
+
+func Sellers(n int) []domain.Seller {
+	sellers := make([]domain.Seller, 0, n)
+	for i := 1; i <= n; i++ {
+		sellers = append(sellers, domain.Seller{ID: i, Name: "Seller " + strconv.Itoa(i)})
+	}
+	return sellers
+}
+
+func Categories(n int) []domain.Category {
+	categories := make([]domain.Category, 0, n)
+	for i := 1; i <= n; i++ {
+		categories = append(categories, domain.Category{ID: i, Name: "Category " + strconv.Itoa(i)})
+	}
+	return categories
+}
+
+func Images(n int) []domain.Image {
+	images := make([]domain.Image, 0, n)
+	for i := 1; i <= n; i++ {
+		images = append(images, domain.Image{ID: i, URL: "https://example.com/image" + strconv.Itoa(i) + ".jpg"})
+	}
+	return images
+}
+
+// Products gera cfg.Size produtos que referenciam sellers, marcas,
+// categorias e imagens entre 1 e cfg.Size. A mesma Config sempre produz
+// o mesmo catálogo.
+func Products(cfg Config) []domain.Product {
+	n := cfg.Size
+	rng := rand.New(rand.NewPCG(cfg.Seed, 0))
+	categoriesPerProduct := cfg.Categories.sampler(rng)
+	imagesPerProduct := cfg.Images.sampler(rng)
+
+	products := make([]domain.Product, 0, n)
+	for i := 1; i <= n; i++ {
+		products = append(products, domain.Product{
+			ID:          i,
+			Name:        "Nome do produto " + strconv.Itoa(i),
+			Slug:        "nome-do-produto-" + strconv.Itoa(i),
+			Description: "Descrição do Produto " + strconv.Itoa(i),
+			Price: domain.Price{
+				Original:     float64(rng.IntN(100) + 1),
+				SpecialPrice: float64(rng.IntN(10) + 1),
+			},
+			SellerID:   rng.IntN(n) + 1,
+			BrandID:    rng.IntN(n) + 1,
+			Categories: randomIDs(rng, categoriesPerProduct(), n),
+			Images:     randomIDs(rng, imagesPerProduct(), n),
+		})
+	}
+	return products
+}
+
+// randomIDs sorteia k IDs distintos entre 1 e n
+func randomIDs(rng *rand.Rand, k, n int) []int {
+	set := make(map[int]struct{}, k)
+	result := make([]int, 0, k)
+	for len(result) < k {
+		id := rng.IntN(n) + 1
+		if _, exists := set[id]; !exists {
+			set[id] = struct{}{}
+			result = append(result, id)
+		}
+	}
+	return result
+}
@@ -0,0 +1,149 @@
+package dataset
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+
+	"shared/domain"
+)
+
+func TestParseDistribution(t *testing.T) {
+	for text, want := range map[string]Distribution{
+		"fixed:3":      Fixed(3),
+		"2":            Fixed(2),
+		"uniform:1-5":  Uniform(1, 5),
+		"zipf:1.5:0-8": Zipf(1.5, 0, 8),
+		"zipf:2:1-1":   Zipf(2, 1, 1),
+		"fixed:0":      Fixed(0),
+	} {
+		got, err := ParseDistribution(text)
+		if err != nil || got != want {
+			t.Errorf("%q: %+v %v, esperado %+v", text, got, err, want)
+		}
+		// String volta ao formato aceito por ParseDistribution
+		if again, err := ParseDistribution(got.String()); err != nil || again != want {
+			t.Errorf("%q: String() = %q não volta ao mesmo valor", text, got.String())
+		}
+	}
+
+	for _, text := range []string{
+		"",
+		"x",
+		"3-5",
+		"fixed:",
+		"fixed:x",
+		"uniform:3",
+		"uniform:a-2",
+		"uniform:1-b",
+		"zipf:x:1-2",
+		"zipf:1.5",
+		"zipf:1.5:1",
+		"normal:2",
+	} {
+		if d, err := ParseDistribution(text); err == nil {
+			t.Errorf("%q: %+v, esperado erro", text, d)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	config := func(categories, images Distribution) Config {
+		return Config{Size: 10, Seed: DefaultSeed, Categories: categories, Images: images}
+	}
+
+	for name, cfg := range map[string]Config{
+		"padrão":           DefaultConfig(),
+		"máximo no limite": config(Fixed(10), Uniform(0, 10)),
+		"zipf":             config(Zipf(1.1, 0, 10), Fixed(0)),
+	} {
+		if err := cfg.Validate(); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+
+	for name, cfg := range map[string]Config{
+		"catálogo vazio":          {Size: 0, Categories: Fixed(0), Images: Fixed(0)},
+		"fixa maior que Size":     config(Fixed(11), Fixed(1)),
+		"máximo maior que Size":   config(Fixed(1), Uniform(1, 11)),
+		"mínimo negativo":         config(Uniform(-1, 2), Fixed(1)),
+		"máximo menor que mínimo": config(Fixed(1), Uniform(3, 2)),
+		"expoente 1":              config(Zipf(1, 1, 3), Fixed(1)),
+		"expoente menor que 1":    config(Fixed(1), Zipf(0.5, 1, 3)),
+	} {
+		if err := cfg.Validate(); err == nil {
+			t.Errorf("%s: %+v aceita", name, cfg)
+		}
+	}
+}
+
+// As quantidades sorteadas ficam em [Min, Max] e os IDs de cada produto são
+// distintos e existem no catálogo
+func TestProductsDistribution(t *testing.T) {
+	for _, d := range []Distribution{Fixed(3), Uniform(1, 5), Zipf(1.5, 0, 8), Uniform(20, 20)} {
+		cfg := DefaultConfig()
+		cfg.Size = 20
+		cfg.Categories, cfg.Images = d, d
+
+		counts := map[int]int{}
+		for _, p := range Products(cfg) {
+			for field, ids := range map[string][]int{"categorias": p.Categories, "imagens": p.Images} {
+				if len(ids) < d.Min || len(ids) > d.Max {
+					t.Fatalf("%s: produto %d com %d %s", d, p.ID, len(ids), field)
+				}
+				counts[len(ids)]++
+
+				seen := map[int]bool{}
+				for _, id := range ids {
+					if id < 1 || id > cfg.Size || seen[id] {
+						t.Fatalf("%s: produto %d com %s %v", d, p.ID, field, ids)
+					}
+					seen[id] = true
+				}
+			}
+		}
+		if d.Min != d.Max && len(counts) < 2 {
+			t.Errorf("%s: todas as quantidades iguais: %v", d, counts)
+		}
+	}
+}
+
+// A mesma Config gera o mesmo catálogo em qualquer processo; é o que deixa
+// as stacks comparáveis entre si
+func TestProductsDeterministic(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Categories, cfg.Images = Uniform(1, 4), Zipf(1.5, 1, 6)
+	if a, b := Products(cfg), Products(cfg); !reflect.DeepEqual(a, b) {
+		t.Error("a mesma Config gerou catálogos diferentes")
+	}
+
+	other := cfg
+	other.Seed++
+	if reflect.DeepEqual(Products(cfg), Products(other)) {
+		t.Errorf("as seeds %d e %d geraram o mesmo catálogo", cfg.Seed, other.Seed)
+	}
+}
+
+// Os primeiros produtos da seed padrão; mudar o gerador ou a ordem dos
+// sorteios muda o catálogo de todas as stacks e invalida os resultados já
+// coletados
+func TestProductsSeed1(t *testing.T) {
+	want := []struct {
+		price            domain.Price
+		seller, brand    int
+		categories, imgs []int
+	}{
+		{domain.Price{Original: 60, SpecialPrice: 1}, 72, 3, []int{71}, []int{56}},
+		{domain.Price{Original: 82, SpecialPrice: 6}, 31, 14, []int{50}, []int{8}},
+	}
+
+	products := Products(DefaultConfig())
+	for i, w := range want {
+		p := products[i]
+		if p.ID != i+1 || p.Slug != "nome-do-produto-"+strconv.Itoa(i+1) || p.Price != w.price || p.SellerID != w.seller ||
+			p.BrandID != w.brand || !slices.Equal(p.Categories, w.categories) || !slices.Equal(p.Images, w.imgs) {
+			t.Errorf("produto %d: %+v, esperado %+v", i+1, p, w)
+		}
+	}
+}
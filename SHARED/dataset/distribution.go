@@ -0,0 +1,135 @@
+package dataset
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+const (
+	KindFixed   = "fixed"
+	KindUniform = "uniform"
+	KindZipf    = "zipf"
+)
+
+// Distribution define quantos IDs de um contexto cada produto referencia.
+// No formato texto aceita "fixed:N" (ou só "N"), "uniform:MIN-MAX" e
+// "zipf:S:MIN-MAX", em que S > 1 é o expoente: quanto maior, mais produtos
+// ficam perto de MIN.
+type Distribution struct {
+	Kind string
+	Min  int
+	Max  int
+	S    float64
+}
+
+func Fixed(n int) Distribution {
+	return Distribution{Kind: KindFixed, Min: n, Max: n}
+}
+
+func Uniform(min, max int) Distribution {
+	return Distribution{Kind: KindUniform, Min: min, Max: max}
+}
+
+func Zipf(s float64, min, max int) Distribution {
+	return Distribution{Kind: KindZipf, Min: min, Max: max, S: s}
+}
+
+func ParseDistribution(text string) (Distribution, error) {
+	kind, args, found := strings.Cut(text, ":")
+	if !found {
+		kind, args = KindFixed, text
+	}
+
+	switch kind {
+	case KindFixed:
+		n, err := strconv.Atoi(args)
+		if err != nil {
+			return Distribution{}, fmt.Errorf("distribuição fixa inválida %q", text)
+		}
+		return Fixed(n), nil
+	case KindUniform:
+		min, max, err := parseRange(args)
+		if err != nil {
+			return Distribution{}, fmt.Errorf("distribuição uniforme inválida %q: %w", text, err)
+		}
+		return Uniform(min, max), nil
+	case KindZipf:
+		sText, rangeText, _ := strings.Cut(args, ":")
+		s, err := strconv.ParseFloat(sText, 64)
+		if err != nil {
+			return Distribution{}, fmt.Errorf("distribuição zipf inválida %q: expoente %q", text, sText)
+		}
+		min, max, err := parseRange(rangeText)
+		if err != nil {
+			return Distribution{}, fmt.Errorf("distribuição zipf inválida %q: %w", text, err)
+		}
+		return Zipf(s, min, max), nil
+	}
+	return Distribution{}, fmt.Errorf("distribuição desconhecida %q", text)
+}
+
+func parseRange(text string) (int, int, error) {
+	minText, maxText, found := strings.Cut(text, "-")
+	if !found {
+		return 0, 0, fmt.Errorf("intervalo %q deve ter o formato MIN-MAX", text)
+	}
+	min, err := strconv.Atoi(minText)
+	if err != nil {
+		return 0, 0, fmt.Errorf("mínimo %q", minText)
+	}
+	max, err := strconv.Atoi(maxText)
+	if err != nil {
+		return 0, 0, fmt.Errorf("máximo %q", maxText)
+	}
+	return min, max, nil
+}
+
+func (d Distribution) String() string {
+	switch d.Kind {
+	case KindUniform:
+		return fmt.Sprintf("%s:%d-%d", d.Kind, d.Min, d.Max)
+	case KindZipf:
+		return fmt.Sprintf("%s:%s:%d-%d", d.Kind, strconv.FormatFloat(d.S, 'g', -1, 64), d.Min, d.Max)
+	}
+	return fmt.Sprintf("%s:%d", KindFixed, d.Min)
+}
+
+// Set implementa flag.Value
+func (d *Distribution) Set(text string) error {
+	parsed, err := ParseDistribution(text)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// validate garante que a distribuição cabe em um catálogo de size registros,
+// já que os IDs de um produto não se repetem
+func (d Distribution) validate(size int) error {
+	if d.Min < 0 || d.Max < d.Min {
+		return fmt.Errorf("intervalo inválido %d-%d", d.Min, d.Max)
+	}
+	if d.Max > size {
+		return fmt.Errorf("máximo %d maior que o catálogo (%d)", d.Max, size)
+	}
+	if d.Kind == KindZipf && d.S <= 1 {
+		return fmt.Errorf("expoente zipf deve ser maior que 1: %g", d.S)
+	}
+	return nil
+}
+
+// sampler devolve uma função que sorteia a quantidade de IDs de cada
+// produto. A distribuição fixa não consome números do gerador.
+func (d Distribution) sampler(rng *rand.Rand) func() int {
+	switch d.Kind {
+	case KindUniform:
+		return func() int { return d.Min + rng.IntN(d.Max-d.Min+1) }
+	case KindZipf:
+		z := rand.NewZipf(rng, d.S, 1, uint64(d.Max-d.Min))
+		return func() int { return d.Min + int(z.Uint64()) }
+	}
+	return func() int { return d.Min }
+}
@@ -0,0 +1,99 @@
+// Package domain contém os tipos canônicos de cada contexto. Todas as
+// stacks (JSON, MessagePack, CBOR, Protobuf e gRPC) partem destes tipos,
+// então os payloads comparados nos testes de carga têm exatamente o mesmo
+// conteúdo.
+package domain
+
+type Brand struct {
+	ID          int    `json:"id" msgpack:"id" cbor:"id"`
+	Name        string `json:"name" msgpack:"name" cbor:"name"`
+	Description string `json:"description" msgpack:"description" cbor:"description"`
+	Country     string `json:"country" msgpack:"country" cbor:"country"`
+	Active      bool   `json:"active" msgpack:"active" cbor:"active"`
+}
+
+type Seller struct {
+	ID   int    `json:"id" msgpack:"id" cbor:"id"`
+	Name string `json:"name" msgpack:"name" cbor:"name"`
+}
+
+type Category struct {
+	ID   int    `json:"id" msgpack:"id" cbor:"id"`
+	Name string `json:"name" msgpack:"name" cbor:"name"`
+}
+
+type Image struct {
+	ID  int    `json:"id" msgpack:"id" cbor:"id"`
+	URL string `json:"url" msgpack:"url" cbor:"url"`
+}
+
+type Price struct {
+	Original     float64 `json:"original" msgpack:"original" cbor:"original"`
+	SpecialPrice float64 `json:"special_price" msgpack:"special_price" cbor:"special_price"`
+}
+
+type Product struct {
+	ID          int    `json:"id" msgpack:"id" cbor:"id"`
+	Name        string `json:"name" msgpack:"name" cbor:"name"`
+	Slug        string `json:"slug" msgpack:"slug" cbor:"slug"`
+	Description string `json:"description" msgpack:"description" cbor:"description"`
+	Price       Price  `json:"price" msgpack:"price" cbor:"price"`
+	SellerID    int    `json:"seller_id" msgpack:"seller_id" cbor:"seller_id"`
+	BrandID     int    `json:"brand_id" msgpack:"brand_id" cbor:"brand_id"`
+	Categories  []int  `json:"categories" msgpack:"categories" cbor:"categories"`
+	Images      []int  `json:"images" msgpack:"images" cbor:"images"`
+}
+
+// EnrichedProduct é o produto com as entidades dos outros contextos, montado
+// pela products-api quando ela conhece os endereços deles. Entidades que não
+// puderam ser buscadas ficam nil e são explicadas em Errors.
+type EnrichedProduct struct {
+	ID          int           `json:"id" msgpack:"id" cbor:"id"`
+	Name        string        `json:"name" msgpack:"name" cbor:"name"`
+	Slug        string        `json:"slug" msgpack:"slug" cbor:"slug"`
+	Description string        `json:"description" msgpack:"description" cbor:"description"`
+	Price       Price         `json:"price" msgpack:"price" cbor:"price"`
+	Seller      *Seller       `json:"seller" msgpack:"seller" cbor:"seller"`
+	Brand       *Brand        `json:"brand" msgpack:"brand" cbor:"brand"`
+	Categories  []*Category   `json:"categories" msgpack:"categories" cbor:"categories"`
+	Images      []*Image      `json:"images" msgpack:"images" cbor:"images"`
+	Errors      []EnrichError `json:"errors,omitempty" msgpack:"errors,omitempty" cbor:"errors,omitempty"`
+}
+
+// EnrichError é uma entidade que a products-api não conseguiu buscar. Field é
+// seller, brand, categories ou images; Index é a posição da entidade em
+// Categories ou Images, zero nas outras.
+type EnrichError struct {
+	Field string `json:"field" msgpack:"field" cbor:"field"`
+	ID    int    `json:"id" msgpack:"id" cbor:"id"`
+	Index int    `json:"index" msgpack:"index" cbor:"index"`
+	Error string `json:"error" msgpack:"error" cbor:"error"`
+}
+
+// WithID devolve uma cópia com o ID dado; as escritas usam para gravar o ID
+// atribuído pelo serviço ou o da rota, nunca o do corpo
+
+func (b Brand) WithID(id int) Brand {
+	b.ID = id
+	return b
+}
+
+func (s Seller) WithID(id int) Seller {
+	s.ID = id
+	return s
+}
+
+func (c Category) WithID(id int) Category {
+	c.ID = id
+	return c
+}
+
+func (img Image) WithID(id int) Image {
+	img.ID = id
+	return img
+}
+
+func (p Product) WithID(id int) Product {
+	p.ID = id
+	return p
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// As validações valem para as escritas de todas as stacks; o ID é atribuído
+// pelo serviço e não é conferido aqui. Referências a outros contextos
+// (seller_id, brand_id, categorias e imagens) só precisam ser IDs válidos.
+
+func (b Brand) Validate() error {
+	return errors.Join(required("name", b.Name), required("country", b.Country))
+}
+
+func (s Seller) Validate() error {
+	return required("name", s.Name)
+}
+
+func (c Category) Validate() error {
+	return required("name", c.Name)
+}
+
+func (img Image) Validate() error {
+	u, err := url.Parse(img.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("url deve ser um endereço http ou https absoluto")
+	}
+	return nil
+}
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+func (p Product) Validate() error {
+	errs := []error{required("name", p.Name)}
+	if !slugPattern.MatchString(p.Slug) {
+		errs = append(errs, errors.New("slug deve ter letras minúsculas, números e hífens, como nome-do-produto-1"))
+	}
+	if p.Price.Original < 0 || p.Price.SpecialPrice < 0 {
+		errs = append(errs, errors.New("price não pode ser negativo"))
+	}
+	if p.SellerID < 1 {
+		errs = append(errs, errors.New("seller_id deve ser positivo"))
+	}
+	if p.BrandID < 1 {
+		errs = append(errs, errors.New("brand_id deve ser positivo"))
+	}
+	errs = append(errs, positiveIDs("categories", p.Categories), positiveIDs("images", p.Images))
+	return errors.Join(errs...)
+}
+
+func required(field, value string) error {
+	if strings.TrimSpace(value) == "" {
+		return errors.New(field + " é obrigatório")
+	}
+	return nil
+}
+
+func positiveIDs(field string, ids []int) error {
+	for _, id := range ids {
+		if id < 1 {
+			return errors.New(field + " deve ter apenas IDs positivos")
+		}
+	}
+	return nil
+}
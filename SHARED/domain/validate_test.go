@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	valid := Product{Name: "Produto", Slug: "produto-1", Price: Price{Original: 10, SpecialPrice: 12}, SellerID: 1, BrandID: 2, Categories: []int{1}, Images: []int{}}
+
+	tests := []struct {
+		name  string
+		value interface{ Validate() error }
+		want  []string
+	}{
+		{"marca", Brand{Name: "Marca", Country: "Brasil"}, nil},
+		{"marca sem nome", Brand{Name: "  ", Country: "Brasil"}, []string{"name"}},
+		{"marca vazia", Brand{}, []string{"name", "country"}},
+		{"seller", Seller{Name: "Seller"}, nil},
+		{"seller sem nome", Seller{}, []string{"name"}},
+		{"categoria sem nome", Category{ID: 1}, []string{"name"}},
+		{"imagem", Image{URL: "https://example.com/image1.jpg"}, nil},
+		{"imagem relativa", Image{URL: "/image1.jpg"}, []string{"url"}},
+		{"imagem ftp", Image{URL: "ftp://example.com/image1.jpg"}, []string{"url"}},
+		{"produto", valid, nil},
+		{"produto vazio", Product{}, []string{"name", "slug", "seller_id", "brand_id"}},
+		{"slug com maiúsculas", Product{Name: "P", Slug: "Produto-1", SellerID: 1, BrandID: 1}, []string{"slug"}},
+		{"slug com hífen no fim", Product{Name: "P", Slug: "produto-", SellerID: 1, BrandID: 1}, []string{"slug"}},
+		{"preço negativo", Product{Name: "P", Slug: "p", Price: Price{Original: -1}, SellerID: 1, BrandID: 1}, []string{"price"}},
+		{"IDs inválidos", Product{Name: "P", Slug: "p", SellerID: 1, BrandID: 1, Categories: []int{1, 0}, Images: []int{-2}}, []string{"categories", "images"}},
+	}
+	for _, tt := range tests {
+		err := tt.value.Validate()
+		if len(tt.want) == 0 {
+			if err != nil {
+				t.Errorf("%s: %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%s: aceito, esperado erro em %v", tt.name, tt.want)
+			continue
+		}
+		lines := strings.Split(err.Error(), "\n")
+		if len(lines) != len(tt.want) {
+			t.Errorf("%s: %q, esperado erros em %v", tt.name, err, tt.want)
+			continue
+		}
+		for i, field := range tt.want {
+			if !strings.HasPrefix(lines[i], field+" ") {
+				t.Errorf("%s: %q, esperado erro em %s", tt.name, lines[i], field)
+			}
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Package enrich monta o produto enriquecido dentro da products-api das
+// stacks HTTP, buscando seller, brand, categorias e imagens nos outros
+// contextos da mesma stack. Assim dá para comparar a composição no BFF com a
+// composição no serviço usando o mesmo protocolo.
+package enrich
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"shared/domain"
+	"shared/negotiate"
+)
+
+// Endpoints são as URLs base dos outros contextos
+type Endpoints struct {
+	Brands     string
+	Sellers    string
+	Categories string
+	Images     string
+}
+
+// EndpointsFromEnv lê BRANDS_API, SELLERS_API, CATEGORIES_API e IMAGES_API.
+// Devolve false se algum faltar: nesse caso o produto enriquecido fica
+// desabilitado.
+func EndpointsFromEnv() (Endpoints, bool) {
+	e := Endpoints{
+		Brands:     os.Getenv("BRANDS_API"),
+		Sellers:    os.Getenv("SELLERS_API"),
+		Categories: os.Getenv("CATEGORIES_API"),
+		Images:     os.Getenv("IMAGES_API"),
+	}
+	ok := e.Brands != "" && e.Sellers != "" && e.Categories != "" && e.Images != ""
+	return e, ok
+}
+
+type Client struct {
+	http      *http.Client
+	format    negotiate.Format
+	endpoints Endpoints
+}
+
+// NewClient busca os contextos no formato informado, o mesmo que a stack
+// serve por padrão
+func NewClient(format negotiate.Format, endpoints Endpoints) *Client {
+	return &Client{
+		http: &http.Client{
+			Transport: &http.Transport{
+				MaxIdleConns:        100,
+				MaxIdleConnsPerHost: 100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+			Timeout: 10 * time.Second,
+		},
+		format:    format,
+		endpoints: endpoints,
+	}
+}
+
+func (c *Client) fetch(ctx context.Context, url string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", c.format.ContentType)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", url, resp.StatusCode)
+	}
+
+	return c.format.Unmarshal(body, target)
+}
+
+// Enrich busca as entidades do produto em paralelo, como o /paralelo do BFF.
+// Falhas deixam a entidade nil e entram em Errors; cancelar ctx interrompe as
+// buscas pendentes.
+func (c *Client) Enrich(ctx context.Context, product domain.Product) domain.EnrichedProduct {
+	enriched := domain.EnrichedProduct{
+		ID:          product.ID,
+		Name:        product.Name,
+		Slug:        product.Slug,
+		Description: product.Description,
+		Price:       product.Price,
+		Categories:  make([]*domain.Category, len(product.Categories)),
+		Images:      make([]*domain.Image, len(product.Images)),
+	}
+
+	var mu sync.Mutex
+	fail := func(field string, id, index int, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		enriched.Errors = append(enriched.Errors, domain.EnrichError{Field: field, ID: id, Index: index, Error: err.Error()})
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2 + len(product.Categories) + len(product.Images))
+
+	go func() {
+		defer wg.Done()
+		var seller domain.Seller
+		if err := c.fetch(ctx, fmt.Sprintf("%s/sellers/%d", c.endpoints.Sellers, product.SellerID), &seller); err != nil {
+			fail("seller", product.SellerID, 0, err)
+		} else {
+			enriched.Seller = &seller
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		var brand domain.Brand
+		if err := c.fetch(ctx, fmt.Sprintf("%s/brands/%d", c.endpoints.Brands, product.BrandID), &brand); err != nil {
+			fail("brand", product.BrandID, 0, err)
+		} else {
+			enriched.Brand = &brand
+		}
+	}()
+
+	for i, id := range product.Categories {
+		go func() {
+			defer wg.Done()
+			var category domain.Category
+			if err := c.fetch(ctx, fmt.Sprintf("%s/categories/%d", c.endpoints.Categories, id), &category); err != nil {
+				fail("categories", id, i, err)
+			} else {
+				enriched.Categories[i] = &category
+			}
+		}()
+	}
+
+	for i, id := range product.Images {
+		go func() {
+			defer wg.Done()
+			var image domain.Image
+			if err := c.fetch(ctx, fmt.Sprintf("%s/images/%d", c.endpoints.Images, id), &image); err != nil {
+				fail("images", id, i, err)
+			} else {
+				enriched.Images[i] = &image
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return enriched
+}
@@ -0,0 +1,57 @@
+// Package grpcserver reúne o que os servidores gRPC dos contextos têm em
+// comum: os erros com ErrorInfo e as escritas sobre um Repository.
+package grpcserver
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain é o serviço que aparece no ErrorInfo dos erros, como "brands-api".
+// Os erros levam o código canônico e um ErrorInfo com o motivo e o registro,
+// para o cliente não depender do texto da mensagem.
+type Domain string
+
+func (d Domain) Error(code codes.Code, reason, message string, metadata map[string]string) error {
+	st, err := status.New(code, message).WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   string(d),
+		Metadata: metadata,
+	})
+	if err != nil {
+		return status.Error(code, message)
+	}
+	return st.Err()
+}
+
+// ID é o erro de um registro buscado pelo ID
+func (d Domain) ID(code codes.Code, reason, message string, id int32) error {
+	return d.Error(code, reason, message, map[string]string{"id": strconv.Itoa(int(id))})
+}
+
+// Slug é o erro de um registro buscado pelo slug
+func (d Domain) Slug(code codes.Code, reason, message, slug string) error {
+	return d.Error(code, reason, message, map[string]string{"slug": slug})
+}
+
+func (d Domain) InvalidID(id int32) error {
+	return d.ID(codes.InvalidArgument, "INVALID_ID", "ID inválido", id)
+}
+
+// NotFound usa o motivo e a mensagem do contexto, como BRAND_NOT_FOUND
+func (d Domain) NotFound(reason, message string, id int32) error {
+	return d.ID(codes.NotFound, reason, message, id)
+}
+
+// Invalid acrescenta a message as violações de Validate, em uma linha
+func (d Domain) Invalid(reason, message string, id int32, err error) error {
+	return d.ID(codes.InvalidArgument, reason, message+": "+strings.ReplaceAll(err.Error(), "\n", "; "), id)
+}
+
+func (d Domain) InvalidFieldMask(id int32, err error) error {
+	return d.ID(codes.InvalidArgument, "INVALID_FIELD_MASK", err.Error(), id)
+}
@@ -0,0 +1,48 @@
+package grpcserver
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDomainErrors(t *testing.T) {
+	const d Domain = "brands-api"
+	for name, tt := range map[string]struct {
+		err      error
+		code     codes.Code
+		reason   string
+		message  string
+		metadata map[string]string
+	}{
+		"InvalidID":        {d.InvalidID(-1), codes.InvalidArgument, "INVALID_ID", "ID inválido", map[string]string{"id": "-1"}},
+		"NotFound":         {d.NotFound("BRAND_NOT_FOUND", "marca não encontrada", 7), codes.NotFound, "BRAND_NOT_FOUND", "marca não encontrada", map[string]string{"id": "7"}},
+		"Invalid":          {d.Invalid("INVALID_BRAND", "marca inválida", 0, errors.New("nome vazio\nnome longo")), codes.InvalidArgument, "INVALID_BRAND", "marca inválida: nome vazio; nome longo", map[string]string{"id": "0"}},
+		"InvalidFieldMask": {d.InvalidFieldMask(3, errors.New("campo desconhecido: cor")), codes.InvalidArgument, "INVALID_FIELD_MASK", "campo desconhecido: cor", map[string]string{"id": "3"}},
+		"Slug":             {d.Slug(codes.AlreadyExists, "DUPLICATE_SLUG", "slug já usado", "a-b"), codes.AlreadyExists, "DUPLICATE_SLUG", "slug já usado", map[string]string{"slug": "a-b"}},
+	} {
+		st := status.Convert(tt.err)
+		if st.Code() != tt.code || st.Message() != tt.message {
+			t.Errorf("%s: %v %q, esperado %v %q", name, st.Code(), st.Message(), tt.code, tt.message)
+		}
+		var info *errdetails.ErrorInfo
+		if details := st.Details(); len(details) == 1 {
+			info, _ = details[0].(*errdetails.ErrorInfo)
+		}
+		if info == nil {
+			t.Errorf("%s: detalhes %v, esperado um ErrorInfo", name, st.Details())
+			continue
+		}
+		if info.Domain != string(d) || info.Reason != tt.reason || len(info.Metadata) != len(tt.metadata) {
+			t.Errorf("%s: ErrorInfo %v", name, info)
+		}
+		for k, v := range tt.metadata {
+			if info.Metadata[k] != v {
+				t.Errorf("%s: metadata %s=%q, esperado %q", name, k, info.Metadata[k], v)
+			}
+		}
+	}
+}
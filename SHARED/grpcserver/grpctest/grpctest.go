@@ -0,0 +1,97 @@
+// Package grpctest sobe servidores gRPC em memória, com bufconn, para os
+// testes dos contextos e do BFF.
+package grpctest
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"shared/grpcserver"
+)
+
+// Target é o endereço a usar com a opção de Listen; o passthrough evita que
+// o grpc.NewClient tente resolvê-lo no DNS
+const Target = "passthrough:///bufnet"
+
+// Listen sobe um servidor gRPC em memória com os serviços de register e
+// devolve a opção de dial que conecta nele; o servidor para no fim do teste
+func Listen(t testing.TB, register func(*grpc.Server), opts ...grpc.ServerOption) grpc.DialOption {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	s := grpc.NewServer(opts...)
+	register(s)
+	go s.Serve(listener)
+	t.Cleanup(s.Stop)
+
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	})
+}
+
+// Dial é Listen com a conexão já criada, fechada no fim do teste
+func Dial(t testing.TB, register func(*grpc.Server), opts ...grpc.ServerOption) *grpc.ClientConn {
+	t.Helper()
+	conn, err := grpc.NewClient(Target, Listen(t, register, opts...), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// StreamResult é como terminou um handler de stream do servidor: quantas
+// mensagens ele conseguiu enviar e o erro que devolveu
+type StreamResult struct {
+	Sent int
+	Err  error
+}
+
+// RecordStreams é a opção de servidor que publica em results o resultado de
+// cada handler de stream, para o teste saber se o servidor parou de enviar
+func RecordStreams(results chan<- StreamResult) grpc.ServerOption {
+	return grpc.StreamInterceptor(func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		counted := &countingStream{ServerStream: ss}
+		err := handler(srv, counted)
+		results <- StreamResult{Sent: counted.sent, Err: err}
+		return err
+	})
+}
+
+type countingStream struct {
+	grpc.ServerStream
+	sent int
+}
+
+func (s *countingStream) SendMsg(m any) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	s.sent++
+	return nil
+}
+
+// CheckStatus confere o código de err e o domínio e o motivo do ErrorInfo
+func CheckStatus(t testing.TB, err error, code codes.Code, domain grpcserver.Domain, reason string) {
+	t.Helper()
+	st := status.Convert(err)
+	if st.Code() != code {
+		t.Fatalf("código %v, esperado %v: %v", st.Code(), code, err)
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			if info.Domain != string(domain) || info.Reason != reason {
+				t.Errorf("ErrorInfo %s/%s, esperado %s/%s", info.Domain, info.Reason, domain, reason)
+			}
+			return
+		}
+	}
+	t.Errorf("%v sem ErrorInfo", err)
+}
@@ -0,0 +1,41 @@
+package grpcserver
+
+import (
+	"context"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// EnrichStream serve o EnrichStream de um contexto: responde cada pedido
+// assim que ele chega, com a mesma tag, buscando o ID com get, o mesmo
+// caminho da chamada unária. Um ID inexistente não encerra o stream; respond
+// recebe o status do erro, nil no sucesso, e monta a resposta com o item ou
+// com a mensagem e o código.
+func EnrichStream[Req, Res, T any](
+	stream grpc.BidiStreamingServer[Req, Res],
+	tag func(*Req) uint64,
+	id func(*Req) int32,
+	get func(ctx context.Context, id int32) (T, error),
+	respond func(tag uint64, item T, st *status.Status) *Res,
+) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		item, err := get(stream.Context(), id(req))
+		var st *status.Status
+		if err != nil {
+			st = status.Convert(err)
+		}
+		if err := stream.Send(respond(tag(req), item, st)); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package grpcserver_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"shared/grpcserver"
+	"shared/grpcserver/grpctest"
+	brandpb "shared/proto/brand"
+)
+
+// enrichBrands serve o EnrichStream com o repositório de newBrandWrites
+type enrichBrands struct {
+	brandpb.UnimplementedBrandServiceServer
+	writes *grpcserver.Writes[*brandpb.Brand]
+}
+
+func (s enrichBrands) EnrichStream(stream grpc.BidiStreamingServer[brandpb.BrandStreamRequest, brandpb.BrandStreamResponse]) error {
+	return grpcserver.EnrichStream(stream, (*brandpb.BrandStreamRequest).GetTag, (*brandpb.BrandStreamRequest).GetId,
+		func(_ context.Context, id int32) (*brandpb.Brand, error) {
+			if b, ok := s.writes.Repo.ByID(int(id)); ok {
+				return b, nil
+			}
+			return nil, s.writes.NotFound(id)
+		},
+		func(tag uint64, brand *brandpb.Brand, st *status.Status) *brandpb.BrandStreamResponse {
+			return &brandpb.BrandStreamResponse{Tag: tag, Brand: brand, Error: st.Message(), Code: int32(st.Code())}
+		})
+}
+
+// Cada pedido recebe a resposta com a própria tag e um ID inexistente não
+// encerra o stream
+func TestEnrichStream(t *testing.T) {
+	conn := grpctest.Dial(t, func(s *grpc.Server) {
+		brandpb.RegisterBrandServiceServer(s, enrichBrands{writes: newBrandWrites()})
+	})
+	stream, err := brandpb.NewBrandServiceClient(conn).EnrichStream(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		tag  uint64
+		id   int32
+		code codes.Code
+	}{
+		{tag: 7, id: 2, code: codes.OK},
+		{tag: 8, id: 99, code: codes.NotFound},
+		{tag: 9, id: 3, code: codes.OK},
+	} {
+		if err := stream.Send(&brandpb.BrandStreamRequest{Tag: tt.tag, Id: tt.id}); err != nil {
+			t.Fatal(err)
+		}
+		res, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Tag != tt.tag || codes.Code(res.Code) != tt.code || (tt.code == codes.OK) != (res.Brand.GetId() == tt.id) {
+			t.Errorf("tag %d, id %d: %v, esperado o código %s", tt.tag, tt.id, res, tt.code)
+		}
+		if tt.code != codes.OK && res.Error == "" {
+			t.Errorf("tag %d: erro sem mensagem", tt.tag)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,115 @@
+package grpcserver
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"shared/proto/patch"
+	"shared/repository"
+)
+
+// Writes faz as escritas de um contexto sobre Repo com as mesmas regras das
+// stacks HTTP: Create ignora o id recebido e devolve o atribuído, Update troca
+// o registro inteiro e Patch altera só os campos da máscara, ou os
+// preenchidos quando ela vem vazia. As mensagens precisam ter o ID no campo
+// id. As escritas gravam sempre uma cópia, porque as mensagens já publicadas
+// pelo repositório podem estar sendo enviadas a outros clientes.
+type Writes[M proto.Message] struct {
+	Repo   *repository.Repository[M]
+	Errors Domain
+	// Validate confere a mensagem como as stacks HTTP conferem o registro
+	Validate func(item M) error
+	// Invalid e NotFound montam os erros com o motivo do contexto
+	Invalid  func(id int32, err error) error
+	NotFound func(id int32) error
+	// Conflict monta o erro de um slug repetido; só é chamado em
+	// repositórios com WithSlug
+	Conflict func(item M) error
+}
+
+func (w *Writes[M]) Create(req M) (M, error) {
+	var zero M
+	if err := w.Validate(req); err != nil {
+		return zero, w.Invalid(0, err)
+	}
+	item, err := w.Repo.Create(func(id int) M { return withID(req, int32(id)) })
+	if err != nil {
+		return zero, w.conflict(err, req)
+	}
+	return item, nil
+}
+
+// Update troca o registro de ID id por req
+func (w *Writes[M]) Update(id int32, req M) (M, error) {
+	return w.update(id, func(M) (M, error) {
+		return withID(req, id), nil
+	})
+}
+
+// Patch aplica em uma cópia do registro de ID id os campos de src indicados
+// por paths
+func (w *Writes[M]) Patch(id int32, src M, paths []string) (M, error) {
+	return w.update(id, func(current M) (M, error) {
+		item := proto.Clone(current).(M)
+		if err := patch.Apply(item, src, paths); err != nil {
+			var zero M
+			return zero, w.Errors.InvalidFieldMask(id, err)
+		}
+		return item, nil
+	})
+}
+
+// update grava o registro de ID id montado por build a partir do atual, se
+// passar na validação
+func (w *Writes[M]) update(id int32, build func(current M) (M, error)) (M, error) {
+	var zero M
+	if id < 1 {
+		return zero, w.Errors.InvalidID(id)
+	}
+	var built M
+	item, err := w.Repo.Update(int(id), func(current M) (M, error) {
+		item, err := build(current)
+		if err != nil {
+			return zero, err
+		}
+		if err := w.Validate(item); err != nil {
+			return zero, w.Invalid(id, err)
+		}
+		built = item
+		return item, nil
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return zero, w.NotFound(id)
+	}
+	if err != nil {
+		return zero, w.conflict(err, built)
+	}
+	return item, nil
+}
+
+func (w *Writes[M]) Delete(id int32) error {
+	if id < 1 {
+		return w.Errors.InvalidID(id)
+	}
+	if _, err := w.Repo.Delete(int(id)); err != nil {
+		return w.NotFound(id)
+	}
+	return nil
+}
+
+func (w *Writes[M]) conflict(err error, item M) error {
+	if errors.Is(err, repository.ErrDuplicateSlug) && w.Conflict != nil {
+		return w.Conflict(item)
+	}
+	return err
+}
+
+// withID devolve uma cópia de m com o campo id trocado
+func withID[M proto.Message](m M, id int32) M {
+	item := proto.Clone(m).(M)
+	msg := item.ProtoReflect()
+	msg.Set(msg.Descriptor().Fields().ByName("id"), protoreflect.ValueOfInt32(id))
+	return item
+}
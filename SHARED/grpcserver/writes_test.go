@@ -0,0 +1,154 @@
+package grpcserver_test
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"shared/dataset"
+	"shared/grpcserver"
+	"shared/grpcserver/grpctest"
+	brandpb "shared/proto/brand"
+	productpb "shared/proto/product"
+	"shared/repository"
+)
+
+const testDomain grpcserver.Domain = "brands-api"
+
+func newBrandWrites() *grpcserver.Writes[*brandpb.Brand] {
+	brands := brandpb.ListFromDomain(dataset.Brands(3)).Brands
+	return &grpcserver.Writes[*brandpb.Brand]{
+		Repo:     repository.New(brands, func(b *brandpb.Brand) int { return int(b.Id) }),
+		Errors:   testDomain,
+		Validate: func(b *brandpb.Brand) error { return b.ToDomain().Validate() },
+		Invalid: func(id int32, err error) error {
+			return testDomain.Invalid("INVALID_BRAND", "marca inválida", id, err)
+		},
+		NotFound: func(id int32) error { return testDomain.NotFound("BRAND_NOT_FOUND", "marca não encontrada", id) },
+	}
+}
+
+func newBrand() *brandpb.Brand {
+	return &brandpb.Brand{Name: "Marca Nova", Description: "Descrição", Country: "Brasil", Active: true}
+}
+
+func TestCreate(t *testing.T) {
+	w := newBrandWrites()
+
+	// o id enviado é ignorado e a mensagem recebida não é alterada
+	req := newBrand()
+	req.Id = 1
+	created, err := w.Create(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Id != 4 || req.Id != 1 {
+		t.Errorf("ID %d com o pedido em %d, esperado 4 e 1", created.Id, req.Id)
+	}
+	if got, _ := w.Repo.ByID(4); got != created {
+		t.Errorf("ByID(4) = %v, esperado %v", got, created)
+	}
+
+	_, err = w.Create(&brandpb.Brand{Name: "Sem país"})
+	grpctest.CheckStatus(t, err, codes.InvalidArgument, testDomain, "INVALID_BRAND")
+	if w.Repo.Len() != 4 {
+		t.Errorf("%d marcas, esperado 4", w.Repo.Len())
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	w := newBrandWrites()
+	published, _ := w.Repo.ByID(2)
+	before := proto.Clone(published)
+
+	req := newBrand()
+	req.Id = 2
+	updated, err := w.Update(2, req)
+	if err != nil || !proto.Equal(updated, req) || updated == req {
+		t.Fatalf("%v %v, esperado uma cópia de %v", updated, err, req)
+	}
+	if !proto.Equal(published, before) {
+		t.Errorf("mensagem publicada alterada: %v", published)
+	}
+
+	for _, tt := range []struct {
+		id     int32
+		req    *brandpb.Brand
+		code   codes.Code
+		reason string
+	}{
+		{9, newBrand(), codes.NotFound, "BRAND_NOT_FOUND"},
+		{0, newBrand(), codes.InvalidArgument, "INVALID_ID"},
+		{2, &brandpb.Brand{Name: "Sem país"}, codes.InvalidArgument, "INVALID_BRAND"},
+	} {
+		_, err := w.Update(tt.id, tt.req)
+		grpctest.CheckStatus(t, err, tt.code, testDomain, tt.reason)
+	}
+}
+
+func TestPatch(t *testing.T) {
+	w := newBrandWrites()
+	before, _ := w.Repo.ByID(2)
+	want := proto.Clone(before).(*brandpb.Brand)
+	want.Name = "Alterada"
+
+	for _, paths := range [][]string{{"name"}, nil} {
+		patched, err := w.Patch(2, &brandpb.Brand{Id: 2, Name: "Alterada"}, paths)
+		if err != nil || !proto.Equal(patched, want) {
+			t.Errorf("%v: %v %v, esperado %v", paths, patched, err, want)
+		}
+	}
+	if before.Name == "Alterada" {
+		t.Error("mensagem publicada alterada")
+	}
+
+	// a máscara zera o campo ausente na mensagem, o que a validação recusa
+	_, err := w.Patch(2, &brandpb.Brand{Id: 2}, []string{"name"})
+	grpctest.CheckStatus(t, err, codes.InvalidArgument, testDomain, "INVALID_BRAND")
+	for _, path := range []string{"id", "desconhecido"} {
+		_, err = w.Patch(2, &brandpb.Brand{Id: 2}, []string{path})
+		grpctest.CheckStatus(t, err, codes.InvalidArgument, testDomain, "INVALID_FIELD_MASK")
+	}
+	_, err = w.Patch(9, &brandpb.Brand{Name: "Alterada"}, nil)
+	grpctest.CheckStatus(t, err, codes.NotFound, testDomain, "BRAND_NOT_FOUND")
+}
+
+func TestDelete(t *testing.T) {
+	w := newBrandWrites()
+
+	if err := w.Delete(1); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := w.Repo.ByID(1); ok || w.Repo.Len() != 2 {
+		t.Errorf("marca 1 ainda presente, %d itens", w.Repo.Len())
+	}
+	grpctest.CheckStatus(t, w.Delete(1), codes.NotFound, testDomain, "BRAND_NOT_FOUND")
+	grpctest.CheckStatus(t, w.Delete(0), codes.InvalidArgument, "brands-api", "INVALID_ID")
+}
+
+// Um slug repetido vira o erro de Conflict, no Create e no Update
+func TestConflict(t *testing.T) {
+	const d grpcserver.Domain = "products-api"
+	cfg := dataset.DefaultConfig()
+	cfg.Size = 2
+	products := productpb.ListFromDomain(dataset.Products(cfg)).Products
+	w := &grpcserver.Writes[*productpb.Product]{
+		Repo:     repository.New(products, func(p *productpb.Product) int { return int(p.Id) }).WithSlug(func(p *productpb.Product) string { return p.Slug }),
+		Errors:   d,
+		Validate: func(p *productpb.Product) error { return p.ToDomain().Validate() },
+		Invalid:  func(id int32, err error) error { return d.Invalid("INVALID_PRODUCT", "produto inválido", id, err) },
+		NotFound: func(id int32) error { return d.NotFound("PRODUCT_NOT_FOUND", "produto não encontrado", id) },
+		Conflict: func(p *productpb.Product) error {
+			return d.Slug(codes.AlreadyExists, "DUPLICATE_SLUG", "slug já usado por outro produto", p.Slug)
+		},
+	}
+
+	duplicate := proto.Clone(products[0]).(*productpb.Product)
+	_, err := w.Create(duplicate)
+	grpctest.CheckStatus(t, err, codes.AlreadyExists, d, "DUPLICATE_SLUG")
+
+	duplicate.Slug = products[1].Slug
+	_, err = w.Update(1, duplicate)
+	grpctest.CheckStatus(t, err, codes.AlreadyExists, d, "DUPLICATE_SLUG")
+}
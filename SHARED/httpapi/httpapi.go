@@ -0,0 +1,153 @@
+// Package httpapi monta as rotas dos contextos das stacks HTTP. As quatro
+// stacks servem as mesmas rotas e só mudam o formato padrão, recebido em
+// formats; os mains leem a configuração e sobem o servidor.
+package httpapi
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"shared/crud"
+	"shared/dataset"
+	"shared/domain"
+	"shared/negotiate"
+	"shared/repository"
+)
+
+// entity são as rotas de um contexto buscado por ID, como a brands-api: path
+// é a coleção, param o nome do ID na rota e notFound a mensagem do 404
+type entity[T crud.Entity[T]] struct {
+	path     string
+	param    string
+	notFound string
+	repo     *repository.Repository[T]
+	id       func(T) int
+	// byIDs habilita GET path?ids=1,2,3, usado pelo /lote do BFF
+	byIDs bool
+}
+
+// Brands monta a brands-api com o catálogo de size marcas
+func Brands(size int, formats negotiate.Formats) (*mux.Router, error) {
+	return entity[domain.Brand]{
+		path:     "/brands",
+		param:    "brandId",
+		notFound: "Marca não encontrada",
+		repo:     repository.Brands(dataset.Brands(size)),
+		id:       func(b domain.Brand) int { return b.ID },
+	}.router(formats)
+}
+
+// Sellers monta a sellers-api com o catálogo de size sellers
+func Sellers(size int, formats negotiate.Formats) (*mux.Router, error) {
+	return entity[domain.Seller]{
+		path:     "/sellers",
+		param:    "sellerId",
+		notFound: "Vendedor não encontrado",
+		repo:     repository.Sellers(dataset.Sellers(size)),
+		id:       func(s domain.Seller) int { return s.ID },
+	}.router(formats)
+}
+
+// Categories monta a categories-api com o catálogo de size categorias
+func Categories(size int, formats negotiate.Formats) (*mux.Router, error) {
+	return entity[domain.Category]{
+		path:     "/categories",
+		param:    "categoryId",
+		notFound: "Categoria não encontrada",
+		repo:     repository.Categories(dataset.Categories(size)),
+		id:       func(cat domain.Category) int { return cat.ID },
+		byIDs:    true,
+	}.router(formats)
+}
+
+// Images monta a images-api com o catálogo de size imagens
+func Images(size int, formats negotiate.Formats) (*mux.Router, error) {
+	return entity[domain.Image]{
+		path:     "/images",
+		param:    "imageId",
+		notFound: "Imagem não encontrada",
+		repo:     repository.Images(dataset.Images(size)),
+		id:       func(img domain.Image) int { return img.ID },
+		byIDs:    true,
+	}.router(formats)
+}
+
+// router serve as leituras, pré-codificadas com PRECOMPUTED_RESPONSES=true,
+// e as escritas de crud.Resource
+func (e entity[T]) router(formats negotiate.Formats) (*mux.Router, error) {
+	responses, err := negotiate.CacheFromEnv(formats, e.repo.All(), e.id)
+	if err != nil {
+		return nil, err
+	}
+
+	crud.KeepCached(e.repo, responses)
+	writes := &crud.Resource[T]{
+		Repo:     e.repo,
+		Formats:  formats,
+		ID:       func(r *http.Request) (int, error) { return crud.ParseID(mux.Vars(r)[e.param]) },
+		Location: func(item T) string { return e.path + "/" + strconv.Itoa(e.id(item)) },
+		NotFound: e.notFound,
+	}
+
+	getAll := func(w http.ResponseWriter, r *http.Request) {
+		responses.WriteAll(w, r, e.repo.All())
+	}
+
+	getByID := func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(mux.Vars(r)[e.param])
+		if err != nil || id < 1 {
+			http.Error(w, "ID inválido", http.StatusBadRequest)
+			return
+		}
+
+		item, ok := e.repo.ByID(id)
+		if !ok {
+			http.Error(w, e.notFound, http.StatusNotFound)
+			return
+		}
+		responses.Write(w, r, id, item)
+	}
+
+	// getByIDs responde na ordem pedida; IDs inexistentes ficam de fora da
+	// lista
+	getByIDs := func(w http.ResponseWriter, r *http.Request) {
+		ids, err := parseIDs(r.URL.Query().Get("ids"))
+		if err != nil {
+			http.Error(w, "ID inválido", http.StatusBadRequest)
+			return
+		}
+
+		formats.Write(w, r, e.repo.ByIDs(ids))
+	}
+
+	item := e.path + "/{" + e.param + "}"
+	r := mux.NewRouter()
+	if e.byIDs {
+		r.HandleFunc(e.path, getByIDs).Methods("GET").Queries("ids", "{ids}")
+	}
+	r.HandleFunc(e.path, getAll).Methods("GET")
+	r.HandleFunc(item, getByID).Methods("GET")
+	r.HandleFunc(e.path, writes.Create).Methods("POST")
+	r.HandleFunc(item, writes.Replace).Methods("PUT")
+	r.HandleFunc(item, writes.Patch).Methods("PATCH")
+	r.HandleFunc(item, writes.Delete).Methods("DELETE")
+	return r, nil
+}
+
+func parseIDs(text string) ([]int, error) {
+	var ids []int
+	if text == "" {
+		return ids, nil
+	}
+	for _, part := range strings.Split(text, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,87 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"shared/dataset"
+	"shared/domain"
+	"shared/negotiate"
+)
+
+const catalogSize = 20
+
+func get(t *testing.T, handler http.Handler, path string) *httptest.ResponseRecorder {
+	t.Helper()
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+func TestEntityReads(t *testing.T) {
+	r, err := Categories(catalogSize, negotiate.Offer(negotiate.JSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	categories := dataset.Categories(catalogSize)
+
+	var one domain.Category
+	if w := get(t, r, "/categories/3"); w.Code != http.StatusOK || json.Unmarshal(w.Body.Bytes(), &one) != nil || one != categories[2] {
+		t.Errorf("/categories/3: %d %s, esperado %+v", w.Code, w.Body, categories[2])
+	}
+
+	var list []domain.Category
+	if w := get(t, r, "/categories?ids=5,99,2"); w.Code != http.StatusOK || json.Unmarshal(w.Body.Bytes(), &list) != nil ||
+		len(list) != 2 || list[0] != categories[4] || list[1] != categories[1] {
+		t.Errorf("/categories?ids=5,99,2: %d %s, esperado as categorias 5 e 2", w.Code, w.Body)
+	}
+
+	for path, status := range map[string]int{
+		"/categories/0":       http.StatusBadRequest,
+		"/categories/abc":     http.StatusBadRequest,
+		"/categories/99":      http.StatusNotFound,
+		"/categories?ids=1,x": http.StatusBadRequest,
+	} {
+		if w := get(t, r, path); w.Code != status {
+			t.Errorf("%s: status %d, esperado %d", path, w.Code, status)
+		}
+	}
+}
+
+func TestEntityWrites(t *testing.T) {
+	r, err := Brands(catalogSize, negotiate.Offer(negotiate.JSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/brands", strings.NewReader(`{"name":"Marca Nova","description":"Descrição","country":"Brasil","active":true}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated || w.Header().Get("Location") != "/brands/21" {
+		t.Fatalf("POST: %d %q %s", w.Code, w.Header().Get("Location"), w.Body)
+	}
+	if w := get(t, r, "/brands/21"); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "Marca Nova") {
+		t.Errorf("GET depois do POST: %d %s", w.Code, w.Body)
+	}
+}
+
+func TestProductsEnrichedOnlyWithEndpoints(t *testing.T) {
+	cfg := dataset.DefaultConfig()
+	cfg.Size = catalogSize
+	slug := dataset.Products(cfg)[0].Slug
+
+	r, err := Products(cfg, negotiate.Offer(negotiate.JSON), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w := get(t, r, "/products/"+slug); w.Code != http.StatusOK {
+		t.Errorf("/products/%s: status %d", slug, w.Code)
+	}
+	if w := get(t, r, "/products/"+slug+"/enriched"); w.Code != http.StatusNotFound {
+		t.Errorf("enriched sem endpoints: status %d, esperado 404", w.Code)
+	}
+}
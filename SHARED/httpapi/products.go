@@ -0,0 +1,84 @@
+package httpapi
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"shared/crud"
+	"shared/dataset"
+	"shared/domain"
+	"shared/enrich"
+	"shared/negotiate"
+	"shared/repository"
+)
+
+// Products monta a products-api com o catálogo de cfg. Com endpoints, serve
+// também o produto enriquecido, buscando as entidades nos outros contextos
+// no formato padrão.
+func Products(cfg dataset.Config, formats negotiate.Formats, endpoints *enrich.Endpoints) (*mux.Router, error) {
+	products := repository.Products(dataset.Products(cfg))
+	log.Printf("Catálogo com %d produtos gerado com seed %d", products.Len(), cfg.Seed)
+
+	responses, err := negotiate.CacheFromEnv(formats, products.All(), func(p domain.Product) int { return p.ID })
+	if err != nil {
+		return nil, err
+	}
+	if responses.Len() > 0 {
+		log.Printf("Respostas pré-codificadas em %d formatos", len(formats))
+	}
+
+	getAll := func(w http.ResponseWriter, r *http.Request) {
+		responses.WriteAll(w, r, products.All())
+	}
+
+	getBySlug := func(w http.ResponseWriter, r *http.Request) {
+		p, ok := products.BySlug(mux.Vars(r)["slug"])
+		if !ok {
+			http.Error(w, "Produto não encontrado", http.StatusNotFound)
+			return
+		}
+		responses.Write(w, r, p.ID, p)
+	}
+
+	crud.KeepCached(products, responses)
+	writes := &crud.Resource[domain.Product]{
+		Repo:    products,
+		Formats: formats,
+		// o slug da rota vira o ID do produto
+		ID: func(r *http.Request) (int, error) {
+			p, ok := products.BySlug(mux.Vars(r)["slug"])
+			if !ok {
+				return 0, repository.ErrNotFound
+			}
+			return p.ID, nil
+		},
+		Location: func(p domain.Product) string { return "/products/" + p.Slug },
+		NotFound: "Produto não encontrado",
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/products", getAll).Methods("GET")
+	r.HandleFunc("/products/{slug}", getBySlug).Methods("GET")
+	r.HandleFunc("/products", writes.Create).Methods("POST")
+	r.HandleFunc("/products/{slug}", writes.Replace).Methods("PUT")
+	r.HandleFunc("/products/{slug}", writes.Patch).Methods("PATCH")
+	r.HandleFunc("/products/{slug}", writes.Delete).Methods("DELETE")
+
+	// O produto enriquecido só existe quando os outros contextos estão configurados
+	if endpoints != nil {
+		enricher := enrich.NewClient(formats[0], *endpoints)
+		r.HandleFunc("/products/{slug}/enriched", func(w http.ResponseWriter, r *http.Request) {
+			p, ok := products.BySlug(mux.Vars(r)["slug"])
+			if !ok {
+				http.Error(w, "Produto não encontrado", http.StatusNotFound)
+				return
+			}
+			formats.Write(w, r, enricher.Enrich(r.Context(), p))
+		}).Methods("GET")
+		log.Println("Produto enriquecido disponível em /products/{slug}/enriched")
+	}
+
+	return r, nil
+}
@@ -0,0 +1,155 @@
+package negotiate
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// Encoded é um valor já codificado em cada formato oferecido, pelo
+// ContentType
+type Encoded map[string][]byte
+
+// Encode codifica v em todos os formatos de fs
+func (fs Formats) Encode(v any) (Encoded, error) {
+	encoded := make(Encoded, len(fs))
+	for _, f := range fs {
+		data, err := f.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.ContentType, err)
+		}
+		encoded[f.ContentType] = data
+	}
+	return encoded, nil
+}
+
+// Cache guarda as respostas de um contexto codificadas na
+// inicialização, para separar o custo da serialização do custo do
+// transporte. Um Cache vazio, de NewCache, codifica a cada requisição como
+// Formats.Write.
+type Cache[K comparable] struct {
+	formats Formats
+	mu      sync.RWMutex
+	all     Encoded
+	entries map[K]Encoded
+	// version muda a cada Invalidate, para que uma codificação feita com um
+	// valor lido antes da escrita não volte ao cache
+	version uint64
+	item    func(K) (any, bool)
+	list    func() any
+}
+
+func NewCache[K comparable](fs Formats) *Cache[K] {
+	return &Cache[K]{formats: fs}
+}
+
+// Precompute codifica a lista items e cada item, guardado pela chave de key
+func Precompute[K comparable, T any](fs Formats, items []T, key func(T) K) (*Cache[K], error) {
+	c := &Cache[K]{formats: fs, entries: make(map[K]Encoded, len(items))}
+	var err error
+	if c.all, err = fs.Encode(items); err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if c.entries[key(item)], err = fs.Encode(item); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Reload liga o cache aos dados do contexto: o que Invalidate descartar volta
+// a ser codificado na primeira leitura seguinte, com o valor atual de item ou
+// de list, fora de qualquer lock. Sem Reload, o que foi descartado passa a
+// ser codificado a cada requisição. Deve ser chamado antes de servir.
+func (c *Cache[K]) Reload(item func(key K) (any, bool), list func() any) {
+	c.item, c.list = item, list
+}
+
+// Invalidate descarta a lista e o item de chave key depois de uma escrita no
+// contexto; um Cache vazio continua vazio
+func (c *Cache[K]) Invalidate(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		return
+	}
+	c.all = nil
+	delete(c.entries, key)
+	c.version++
+}
+
+// WriteAll responde a lista completa, v, com os bytes guardados se houver
+func (c *Cache[K]) WriteAll(w http.ResponseWriter, r *http.Request, v any) {
+	c.mu.RLock()
+	encoded, version, precomputed := c.all, c.version, c.entries != nil
+	c.mu.RUnlock()
+	if encoded == nil && precomputed && c.list != nil {
+		encoded = c.reload(version, c.list(), func(e Encoded) { c.all = e })
+	}
+	c.write(w, r, encoded, v)
+}
+
+// Write responde o item de chave key, v, com os bytes guardados se houver
+func (c *Cache[K]) Write(w http.ResponseWriter, r *http.Request, key K, v any) {
+	c.mu.RLock()
+	encoded, version, precomputed := c.entries[key], c.version, c.entries != nil
+	c.mu.RUnlock()
+	if encoded == nil && precomputed && c.item != nil {
+		if item, ok := c.item(key); ok {
+			encoded = c.reload(version, item, func(e Encoded) { c.entries[key] = e })
+		}
+	}
+	c.write(w, r, encoded, v)
+}
+
+// reload codifica v, lido depois de version, e o guarda com store se nenhum
+// Invalidate aconteceu no meio; o que falhar ao codificar não é guardado
+func (c *Cache[K]) reload(version uint64, v any, store func(Encoded)) Encoded {
+	encoded, err := c.formats.Encode(v)
+	if err != nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.version == version {
+		store(encoded)
+	}
+	return encoded
+}
+
+func (c *Cache[K]) write(w http.ResponseWriter, r *http.Request, encoded Encoded, v any) {
+	if encoded == nil {
+		c.formats.Write(w, r, v)
+		return
+	}
+	c.formats.write(w, r, http.StatusOK, func(f Format) ([]byte, error) {
+		return encoded[f.ContentType], nil
+	})
+}
+
+// CacheFromEnv devolve o Cache pré-codificado de items quando
+// PRECOMPUTED_RESPONSES é true e um Cache vazio quando é false ou ausente
+func CacheFromEnv[K comparable, T any](fs Formats, items []T, key func(T) K) (*Cache[K], error) {
+	v := os.Getenv("PRECOMPUTED_RESPONSES")
+	if v == "" {
+		return NewCache[K](fs), nil
+	}
+	enabled, err := strconv.ParseBool(v)
+	if err != nil {
+		return nil, fmt.Errorf("PRECOMPUTED_RESPONSES inválido: %q", v)
+	}
+	if !enabled {
+		return NewCache[K](fs), nil
+	}
+	return Precompute(fs, items, key)
+}
+
+// Len é a quantidade de itens pré-codificados, sem contar a lista
+func (c *Cache[K]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.entries)
+}
@@ -0,0 +1,178 @@
+package negotiate
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"shared/dataset"
+	"shared/domain"
+)
+
+// get responde a requisição com Accept accept usando write
+func get(accept string, write func(w http.ResponseWriter, r *http.Request)) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if accept != "" {
+		r.Header.Set("Accept", accept)
+	}
+	w := httptest.NewRecorder()
+	write(w, r)
+	return w
+}
+
+// O Cache pré-codificado responde os mesmos bytes e headers que Write
+func TestCacheMatchesWrite(t *testing.T) {
+	formats := Offer(MsgPack)
+	brands := dataset.Brands(10)
+	cache, err := Precompute(formats, brands, func(b domain.Brand) int { return b.ID })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, accept := range []string{"", "application/json", "application/cbor", "application/x-protobuf", "application/msgpack", "text/html"} {
+		want := get(accept, func(w http.ResponseWriter, r *http.Request) { formats.Write(w, r, brands) })
+		got := get(accept, func(w http.ResponseWriter, r *http.Request) { cache.WriteAll(w, r, nil) })
+		compare(t, accept+" lista", got, want)
+
+		for _, b := range brands {
+			want := get(accept, func(w http.ResponseWriter, r *http.Request) { formats.Write(w, r, b) })
+			got := get(accept, func(w http.ResponseWriter, r *http.Request) { cache.Write(w, r, b.ID, nil) })
+			compare(t, accept+" "+b.Name, got, want)
+		}
+	}
+}
+
+// Chaves fora do cache e o Cache vazio codificam o valor recebido
+func TestCacheFallback(t *testing.T) {
+	formats := Offer(JSON)
+	seller := domain.Seller{ID: 99, Name: "Seller 99"}
+	want := get("", func(w http.ResponseWriter, r *http.Request) { formats.Write(w, r, seller) })
+
+	cache, err := Precompute(formats, dataset.Sellers(3), func(s domain.Seller) int { return s.ID })
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, c := range map[string]*Cache[int]{"precomputado": cache, "vazio": NewCache[int](formats)} {
+		got := get("", func(w http.ResponseWriter, r *http.Request) { c.Write(w, r, seller.ID, seller) })
+		compare(t, name, got, want)
+	}
+
+	got := get("", func(w http.ResponseWriter, r *http.Request) {
+		NewCache[int](formats).WriteAll(w, r, []domain.Seller{seller})
+	})
+	if !bytes.Equal(got.Body.Bytes(), []byte(`[{"id":99,"name":"Seller 99"}]`+"\n")) {
+		t.Errorf("WriteAll sem cache: %s", got.Body)
+	}
+}
+
+func compare(t *testing.T, name string, got, want *httptest.ResponseRecorder) {
+	t.Helper()
+	if got.Code != want.Code || got.Header().Get("Content-Type") != want.Header().Get("Content-Type") || got.Header().Get("Vary") != want.Header().Get("Vary") {
+		t.Errorf("%s: %d %q, esperado %d %q", name, got.Code, got.Header().Get("Content-Type"), want.Code, want.Header().Get("Content-Type"))
+	}
+	if !bytes.Equal(got.Body.Bytes(), want.Body.Bytes()) {
+		t.Errorf("%s: corpo %q, esperado %q", name, got.Body, want.Body)
+	}
+}
+
+func TestCacheFromEnv(t *testing.T) {
+	formats := Offer(CBOR)
+	images := dataset.Images(5)
+	key := func(img domain.Image) int { return img.ID }
+
+	for value, want := range map[string]int{"": 0, "false": 0, "true": len(images), "1": len(images)} {
+		t.Setenv("PRECOMPUTED_RESPONSES", value)
+		cache, err := CacheFromEnv(formats, images, key)
+		if err != nil {
+			t.Fatalf("%q: %v", value, err)
+		}
+		if cache.Len() != want {
+			t.Errorf("%q: %d itens, esperado %d", value, cache.Len(), want)
+		}
+	}
+
+	t.Setenv("PRECOMPUTED_RESPONSES", "talvez")
+	if _, err := CacheFromEnv(formats, images, key); err == nil {
+		t.Error("valor inválido aceito")
+	}
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	formats := Offer(JSON)
+	sellers := dataset.Sellers(3)
+	cache, err := Precompute(formats, sellers, func(s domain.Seller) int { return s.ID })
+	if err != nil {
+		t.Fatal(err)
+	}
+	current := slices.Clone(sellers)
+	reads := 0
+	cache.Reload(func(id int) (any, bool) {
+		reads++
+		for _, s := range current {
+			if s.ID == id {
+				return s, true
+			}
+		}
+		return nil, false
+	}, func() any { return current })
+
+	updated := domain.Seller{ID: 2, Name: "Alterado"}
+	current[1] = updated
+	cache.Invalidate(updated.ID)
+	if cache.Len() != 2 {
+		t.Errorf("%d itens depois de Invalidate, esperado 2", cache.Len())
+	}
+
+	// o item descartado é lido de novo em Reload, não do valor passado a Write
+	for range 2 {
+		got := get("", func(w http.ResponseWriter, r *http.Request) { cache.Write(w, r, 2, sellers[1]) })
+		want := get("", func(w http.ResponseWriter, r *http.Request) { formats.Write(w, r, updated) })
+		compare(t, "item alterado", got, want)
+	}
+	if reads != 1 || cache.Len() != 3 {
+		t.Errorf("%d leituras e %d itens, esperado 1 e 3", reads, cache.Len())
+	}
+	got := get("", func(w http.ResponseWriter, r *http.Request) { cache.WriteAll(w, r, sellers) })
+	want := get("", func(w http.ResponseWriter, r *http.Request) { formats.Write(w, r, current) })
+	compare(t, "lista alterada", got, want)
+
+	current = current[:2]
+	cache.Invalidate(3)
+	got = get("", func(w http.ResponseWriter, r *http.Request) { cache.Write(w, r, 3, sellers[2]) })
+	want = get("", func(w http.ResponseWriter, r *http.Request) { formats.Write(w, r, sellers[2]) })
+	compare(t, "item removido", got, want)
+	if cache.Len() != 2 {
+		t.Errorf("%d itens, esperado 2", cache.Len())
+	}
+
+	empty := NewCache[int](formats)
+	empty.Reload(func(int) (any, bool) { return sellers[0], true }, func() any { return sellers })
+	empty.Invalidate(1)
+	get("", func(w http.ResponseWriter, r *http.Request) { empty.Write(w, r, 1, sellers[0]) })
+	if empty.Len() != 0 {
+		t.Error("Reload preencheu um Cache vazio")
+	}
+}
+
+// Uma codificação feita com um valor lido antes de uma escrita não é guardada
+func TestCacheReloadRace(t *testing.T) {
+	formats := Offer(JSON)
+	sellers := dataset.Sellers(2)
+	cache, err := Precompute(formats, sellers, func(s domain.Seller) int { return s.ID })
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.Invalidate(1)
+	cache.Reload(func(id int) (any, bool) {
+		// a escrita acontece entre a leitura do valor e o fim da codificação
+		cache.Invalidate(1)
+		return sellers[0], true
+	}, nil)
+
+	get("", func(w http.ResponseWriter, r *http.Request) { cache.Write(w, r, 1, sellers[0]) })
+	if cache.Len() != 1 {
+		t.Errorf("%d itens, esperado 1: o valor antigo voltou ao cache", cache.Len())
+	}
+}
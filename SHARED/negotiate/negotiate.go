@@ -0,0 +1,237 @@
+// Package negotiate escolhe o formato das respostas dos contextos HTTP pelo
+// header Accept, para que uma mesma instância sirva JSON, MessagePack, CBOR
+// e protobuf.
+package negotiate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+// Format é um formato de resposta. ContentType é o enviado na resposta;
+// Aliases são outros media types aceitos no Accept para o mesmo formato.
+// Unmarshal decodifica as respostas de outros contextos e os corpos das
+// escritas no mesmo formato; Merge decodifica sobre o valor atual de v, e só
+// os campos presentes em data o alteram (PATCH).
+type Format struct {
+	ContentType string
+	Aliases     []string
+	Marshal     func(v any) ([]byte, error)
+	Unmarshal   func(data []byte, v any) error
+	Merge       func(data []byte, v any) error
+}
+
+var (
+	JSON = Format{
+		ContentType: "application/json",
+		Marshal:     marshalJSON,
+		Unmarshal:   json.Unmarshal,
+		Merge:       json.Unmarshal,
+	}
+	MsgPack = Format{
+		ContentType: "application/x-msgpack",
+		Aliases:     []string{"application/msgpack", "application/vnd.msgpack"},
+		Marshal:     msgpack.Marshal,
+		Unmarshal:   msgpack.Unmarshal,
+		Merge:       msgpack.Unmarshal,
+	}
+	CBOR = Format{
+		ContentType: "application/cbor",
+		Marshal:     cbor.Marshal,
+		Unmarshal:   cbor.Unmarshal,
+		Merge:       cbor.Unmarshal,
+	}
+	Protobuf = Format{
+		ContentType: "application/x-protobuf",
+		Aliases:     []string{"application/protobuf", "application/vnd.google.protobuf"},
+		Marshal:     marshalProto,
+		Unmarshal:   unmarshalProto,
+		Merge:       mergeProto,
+	}
+)
+
+// mesmo resultado do json.NewEncoder usado antes pelos contextos, com a
+// quebra de linha no fim
+func marshalJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func marshalProto(v any) ([]byte, error) {
+	m, err := toProto(v)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(m)
+}
+
+func (f Format) matches(mediaType string) bool {
+	return mediaType == f.ContentType || slices.Contains(f.Aliases, mediaType)
+}
+
+// Formats são os formatos oferecidos por um serviço. O primeiro é o padrão,
+// usado quando o Accept está ausente ou aceita qualquer tipo.
+type Formats []Format
+
+// Offer devolve todos os formatos, com def primeiro
+func Offer(def Format) Formats {
+	formats := Formats{def}
+	for _, f := range []Format{JSON, MsgPack, CBOR, Protobuf} {
+		if f.ContentType != def.ContentType {
+			formats = append(formats, f)
+		}
+	}
+	return formats
+}
+
+// Negotiate escolhe o formato para o valor de um header Accept, como na RFC
+// 9110: cada formato vale o q do media range mais específico que o aceita, e
+// q=0 o exclui mesmo que um range mais amplo o aceite. Empates ficam com o
+// range que aparece antes no header e depois com a ordem de fs. Devolve false
+// quando nenhum formato oferecido é aceito.
+func (fs Formats) Negotiate(accept string) (Format, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return fs[0], true
+	}
+
+	ranges := parseAccept(accept)
+	best, bestQ, bestPos := -1, 0.0, 0
+	for i, f := range fs {
+		q, pos, ok := quality(ranges, f)
+		if !ok || q <= 0 {
+			continue
+		}
+		if best < 0 || q > bestQ || (q == bestQ && pos < bestPos) {
+			best, bestQ, bestPos = i, q, pos
+		}
+	}
+	if best < 0 {
+		return Format{}, false
+	}
+	return fs[best], true
+}
+
+// Write codifica v no formato pedido pela requisição, ou responde 406
+func (fs Formats) Write(w http.ResponseWriter, r *http.Request, v any) {
+	fs.WriteStatus(w, r, http.StatusOK, v)
+}
+
+// WriteStatus é Write com outro status, como o 201 de uma criação
+func (fs Formats) WriteStatus(w http.ResponseWriter, r *http.Request, status int, v any) {
+	fs.write(w, r, status, func(f Format) ([]byte, error) {
+		return f.Marshal(v)
+	})
+}
+
+// write responde status com o corpo de body para o formato negociado
+func (fs Formats) write(w http.ResponseWriter, r *http.Request, status int, body func(Format) ([]byte, error)) {
+	w.Header().Add("Vary", "Accept")
+
+	accept := r.Header.Get("Accept")
+	f, ok := fs.Negotiate(accept)
+	if !ok {
+		http.Error(w, "Formato não suportado: "+accept, http.StatusNotAcceptable)
+		return
+	}
+
+	data, err := body(f)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", f.ContentType)
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+// ErrUnsupportedMediaType é o corpo com um Content-Type que nenhum formato
+// oferecido decodifica
+var ErrUnsupportedMediaType = errors.New("Content-Type não suportado")
+
+// ReadBody lê o corpo de r e devolve o formato do Content-Type, ou o padrão
+// quando o header está ausente
+func (fs Formats) ReadBody(r *http.Request) (Format, []byte, error) {
+	f := fs[0]
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, _ := strings.Cut(contentType, ";")
+		mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+		i := slices.IndexFunc(fs, func(f Format) bool { return f.matches(mediaType) })
+		if i < 0 {
+			return Format{}, nil, fmt.Errorf("%w: %s", ErrUnsupportedMediaType, contentType)
+		}
+		f = fs[i]
+	}
+	data, err := io.ReadAll(r.Body)
+	return f, data, err
+}
+
+type mediaRange struct {
+	mediaType string
+	q         float64
+}
+
+// specificity diz quão específico é o range que aceita f: 2 para o media
+// type exato ou um alias, 1 para type/*, 0 para */* e -1 quando não aceita
+func (r mediaRange) specificity(f Format) int {
+	if r.mediaType == "*/*" {
+		return 0
+	}
+	if prefix, ok := strings.CutSuffix(r.mediaType, "/*"); ok {
+		if strings.HasPrefix(f.ContentType, prefix+"/") {
+			return 1
+		}
+		return -1
+	}
+	if f.matches(r.mediaType) {
+		return 2
+	}
+	return -1
+}
+
+// quality devolve o q do range mais específico que aceita f e a posição dele
+// no header
+func quality(ranges []mediaRange, f Format) (q float64, pos int, ok bool) {
+	best := -1
+	for i, r := range ranges {
+		if s := r.specificity(f); s > best {
+			q, pos, best = r.q, i, s
+		}
+	}
+	return q, pos, best >= 0
+}
+
+// parseAccept devolve os media ranges na ordem do header, inclusive os com
+// q=0, que excluem formatos
+func parseAccept(accept string) []mediaRange {
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, _ := strings.Cut(part, ";")
+		r := mediaRange{mediaType: strings.ToLower(strings.TrimSpace(mediaType)), q: 1}
+		for _, param := range strings.Split(params, ";") {
+			key, value, _ := strings.Cut(param, "=")
+			if strings.TrimSpace(key) == "q" {
+				if q, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+					r.q = q
+				}
+			}
+		}
+		if r.mediaType != "" {
+			ranges = append(ranges, r)
+		}
+	}
+	return ranges
+}
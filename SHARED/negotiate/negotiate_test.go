@@ -0,0 +1,55 @@
+package negotiate
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNegotiate(t *testing.T) {
+	formats := Offer(JSON)
+
+	tests := []struct {
+		name, accept string
+		want         string // ContentType escolhido; vazio para 406
+	}{
+		{"sem Accept", "", "application/json"},
+		{"exato", "application/cbor", "application/cbor"},
+		{"alias", "application/msgpack", "application/x-msgpack"},
+		{"alias com maiúsculas e parâmetros", "Application/VND.Google.Protobuf; proto=x", "application/x-protobuf"},
+		{"maior q vence", "application/json;q=0.5, application/cbor;q=0.8", "application/cbor"},
+		{"empate fica com o primeiro do header", "application/cbor, application/json", "application/cbor"},
+		{"empate com pesos explícitos", "application/x-protobuf;q=0.7, application/cbor;q=0.7", "application/x-protobuf"},
+		{"*/* usa o padrão", "*/*", "application/json"},
+		{"type/* usa o primeiro oferecido do tipo", "application/*", "application/json"},
+		{"range específico vale mais que */*", "application/json;q=0.2, */*", "application/x-msgpack"},
+		{"q=0 exclui com */*", "application/json;q=0, */*", "application/x-msgpack"},
+		{"q=0 exclui com type/*", "application/*, application/json;q=0, application/x-msgpack;q=0", "application/cbor"},
+		{"q=0 num alias exclui o formato", "application/vnd.msgpack;q=0, application/x-msgpack", ""},
+		{"q=0 em */* não exclui o pedido explícito", "*/*;q=0, application/cbor", "application/cbor"},
+		{"q inválido vale 1", "application/cbor;q=abc", "application/cbor"},
+		{"não oferecido", "text/html", ""},
+		{"tudo excluído", "*/*;q=0", ""},
+		{"type/* de outro tipo", "text/*", ""},
+	}
+	for _, tt := range tests {
+		f, ok := formats.Negotiate(tt.accept)
+		if tt.want == "" {
+			if ok {
+				t.Errorf("%s: %s, esperado 406", tt.name, f.ContentType)
+			}
+			continue
+		}
+		if !ok || f.ContentType != tt.want {
+			t.Errorf("%s: %q %v, esperado %s", tt.name, f.ContentType, ok, tt.want)
+		}
+	}
+}
+
+func TestWriteNotAcceptable(t *testing.T) {
+	w := get("application/json;q=0, text/html", func(w http.ResponseWriter, r *http.Request) {
+		Offer(JSON).Write(w, r, map[string]int{"id": 1})
+	})
+	if w.Code != http.StatusNotAcceptable || w.Header().Get("Vary") != "Accept" {
+		t.Errorf("%d, Vary %q, esperado 406 com Vary: Accept", w.Code, w.Header().Get("Vary"))
+	}
+}
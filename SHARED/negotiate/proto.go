@@ -0,0 +1,133 @@
+package negotiate
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"shared/domain"
+	brandpb "shared/proto/brand"
+	categorypb "shared/proto/category"
+	imagepb "shared/proto/image"
+	productpb "shared/proto/product"
+	sellerpb "shared/proto/seller"
+)
+
+// toProto converte os tipos de domain para as mensagens do .proto; as listas
+// viram as mensagens *List usadas por GetAll*
+func toProto(v any) (proto.Message, error) {
+	switch v := v.(type) {
+	case proto.Message:
+		return v, nil
+	case domain.Brand:
+		return brandpb.FromDomain(v), nil
+	case []domain.Brand:
+		return brandpb.ListFromDomain(v), nil
+	case domain.Seller:
+		return sellerpb.FromDomain(v), nil
+	case []domain.Seller:
+		return sellerpb.ListFromDomain(v), nil
+	case domain.Category:
+		return categorypb.FromDomain(v), nil
+	case []domain.Category:
+		return categorypb.ListFromDomain(v), nil
+	case domain.Image:
+		return imagepb.FromDomain(v), nil
+	case []domain.Image:
+		return imagepb.ListFromDomain(v), nil
+	case domain.Product:
+		return productpb.FromDomain(v), nil
+	case []domain.Product:
+		return productpb.ListFromDomain(v), nil
+	case domain.EnrichedProduct:
+		return productpb.EnrichedFromDomain(v), nil
+	}
+	return nil, fmt.Errorf("tipo sem mensagem protobuf: %T", v)
+}
+
+// unmarshalProto decodifica a mensagem correspondente ao tipo de domain
+// apontado por v
+func unmarshalProto(data []byte, v any) error {
+	switch v := v.(type) {
+	case proto.Message:
+		return proto.Unmarshal(data, v)
+	case *domain.Brand:
+		m := &brandpb.Brand{}
+		if err := proto.Unmarshal(data, m); err != nil {
+			return err
+		}
+		*v = *m.ToDomain()
+	case *domain.Seller:
+		m := &sellerpb.Seller{}
+		if err := proto.Unmarshal(data, m); err != nil {
+			return err
+		}
+		*v = *m.ToDomain()
+	case *domain.Category:
+		m := &categorypb.Category{}
+		if err := proto.Unmarshal(data, m); err != nil {
+			return err
+		}
+		*v = *m.ToDomain()
+	case *domain.Image:
+		m := &imagepb.Image{}
+		if err := proto.Unmarshal(data, m); err != nil {
+			return err
+		}
+		*v = *m.ToDomain()
+	case *domain.Product:
+		m := &productpb.Product{}
+		if err := proto.Unmarshal(data, m); err != nil {
+			return err
+		}
+		*v = *m.ToDomain()
+	case *domain.EnrichedProduct:
+		m := &productpb.EnrichedProduct{}
+		if err := proto.Unmarshal(data, m); err != nil {
+			return err
+		}
+		*v = *m.ToDomain()
+	default:
+		return fmt.Errorf("tipo sem mensagem protobuf: %T", v)
+	}
+	return nil
+}
+
+// mergeProto aplica sobre v os campos presentes na mensagem de data. No
+// proto3 um campo com valor zero não é enviado, então ele não altera v; para
+// zerar um campo use a substituição completa.
+func mergeProto(data []byte, v any) error {
+	var current proto.Message
+	switch v := v.(type) {
+	case *domain.Brand:
+		current = brandpb.FromDomain(*v)
+	case *domain.Seller:
+		current = sellerpb.FromDomain(*v)
+	case *domain.Category:
+		current = categorypb.FromDomain(*v)
+	case *domain.Image:
+		current = imagepb.FromDomain(*v)
+	case *domain.Product:
+		current = productpb.FromDomain(*v)
+	default:
+		return fmt.Errorf("tipo sem mensagem protobuf: %T", v)
+	}
+
+	patch := current.ProtoReflect().New()
+	if err := proto.Unmarshal(data, patch.Interface()); err != nil {
+		return err
+	}
+	// Set substitui listas e mensagens inteiras, como nos outros formatos
+	dst := current.ProtoReflect()
+	patch.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		dst.Set(fd, value)
+		return true
+	})
+
+	merged, err := proto.Marshal(current)
+	if err != nil {
+		return err
+	}
+	return unmarshalProto(merged, v)
+}
@@ -0,0 +1,118 @@
+package negotiate
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"shared/dataset"
+	"shared/domain"
+	productpb "shared/proto/product"
+)
+
+func TestReadBody(t *testing.T) {
+	formats := Offer(MsgPack)
+
+	for contentType, want := range map[string]string{
+		"":                                "application/x-msgpack",
+		"application/json; charset=utf-8": "application/json",
+		"Application/CBOR":                "application/cbor",
+		"application/protobuf":            "application/x-protobuf",
+	} {
+		r := httptest.NewRequest("POST", "/", bytes.NewBufferString("corpo"))
+		if contentType != "" {
+			r.Header.Set("Content-Type", contentType)
+		}
+		f, data, err := formats.ReadBody(r)
+		if err != nil || f.ContentType != want || string(data) != "corpo" {
+			t.Errorf("%q: %s %q %v, esperado %s", contentType, f.ContentType, data, err, want)
+		}
+	}
+
+	r := httptest.NewRequest("POST", "/", nil)
+	r.Header.Set("Content-Type", "text/plain")
+	if _, _, err := formats.ReadBody(r); !errors.Is(err, ErrUnsupportedMediaType) {
+		t.Errorf("%v, esperado ErrUnsupportedMediaType", err)
+	}
+}
+
+// Unmarshal e Merge de cada formato leem o que Marshal escreve
+func TestUnmarshalRoundTrip(t *testing.T) {
+	product := dataset.Products(dataset.DefaultConfig())[0]
+	for _, f := range Offer(JSON) {
+		data, err := f.Marshal(product)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got domain.Product
+		if err := f.Unmarshal(data, &got); err != nil {
+			t.Fatalf("%s: %v", f.ContentType, err)
+		}
+		if !reflect.DeepEqual(got, product) {
+			t.Errorf("%s: %+v, esperado %+v", f.ContentType, got, product)
+		}
+	}
+}
+
+// As entidades que a products-api não buscou voltam como nil na mesma posição
+// em todos os formatos, inclusive no protobuf, que não tem nil em listas
+func TestEnrichedRoundTrip(t *testing.T) {
+	enriched := domain.EnrichedProduct{
+		ID:         1,
+		Name:       "Produto",
+		Slug:       "produto",
+		Brand:      &domain.Brand{ID: 2, Name: "Brand 2"},
+		Categories: []*domain.Category{nil, {ID: 4, Name: "Categoria 4"}, nil},
+		Images:     []*domain.Image{{ID: 6, URL: "http://img/6"}, nil},
+		Errors: []domain.EnrichError{
+			{Field: "seller", ID: 1, Error: "seller fora do ar"},
+			{Field: "categories", ID: 3, Index: 0, Error: "categoria 3 não encontrada"},
+			{Field: "categories", ID: 5, Index: 2, Error: "categoria 5 não encontrada"},
+			{Field: "images", ID: 7, Index: 1, Error: "imagem 7 não encontrada"},
+		},
+	}
+	for _, f := range Offer(JSON) {
+		data, err := f.Marshal(enriched)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got domain.EnrichedProduct
+		if err := f.Unmarshal(data, &got); err != nil {
+			t.Fatalf("%s: %v", f.ContentType, err)
+		}
+		if !reflect.DeepEqual(got, enriched) {
+			t.Errorf("%s: %+v, esperado %+v", f.ContentType, got, enriched)
+		}
+	}
+}
+
+// No PATCH os campos presentes substituem os atuais, listas inteiras, e os
+// ausentes ficam como estão, em todos os formatos
+func TestMerge(t *testing.T) {
+	current := domain.Product{ID: 1, Name: "Produto", Slug: "produto", Description: "Descrição", Price: domain.Price{Original: 10, SpecialPrice: 5}, SellerID: 2, BrandID: 3, Categories: []int{1, 2}, Images: []int{4}}
+	want := current
+	want.Name = "Alterado"
+	want.Categories = []int{7}
+
+	for _, f := range Offer(JSON) {
+		var patch any = map[string]any{"name": "Alterado", "categories": []int{7}}
+		if f.ContentType == Protobuf.ContentType {
+			patch = &productpb.Product{Name: "Alterado", Categories: []int32{7}}
+		}
+		data, err := f.Marshal(patch)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := current
+		got.Categories = []int{1, 2}
+		if err := f.Merge(data, &got); err != nil {
+			t.Fatalf("%s: %v", f.ContentType, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: %+v, esperado %+v", f.ContentType, got, want)
+		}
+	}
+}
@@ -0,0 +1,479 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: proto/brand/brand.proto
+
+package brandpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Brand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Country       string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Brand) Reset() {
+	*x = Brand{}
+	mi := &file_proto_brand_brand_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Brand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Brand) ProtoMessage() {}
+
+func (x *Brand) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_brand_brand_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Brand.ProtoReflect.Descriptor instead.
+func (*Brand) Descriptor() ([]byte, []int) {
+	return file_proto_brand_brand_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Brand) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Brand) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Brand) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Brand) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Brand) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type BrandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandRequest) Reset() {
+	*x = BrandRequest{}
+	mi := &file_proto_brand_brand_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandRequest) ProtoMessage() {}
+
+func (x *BrandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_brand_brand_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandRequest.ProtoReflect.Descriptor instead.
+func (*BrandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_brand_brand_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BrandRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BrandList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Brands        []*Brand               `protobuf:"bytes,1,rep,name=brands,proto3" json:"brands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandList) Reset() {
+	*x = BrandList{}
+	mi := &file_proto_brand_brand_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandList) ProtoMessage() {}
+
+func (x *BrandList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_brand_brand_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandList.ProtoReflect.Descriptor instead.
+func (*BrandList) Descriptor() ([]byte, []int) {
+	return file_proto_brand_brand_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BrandList) GetBrands() []*Brand {
+	if x != nil {
+		return x.Brands
+	}
+	return nil
+}
+
+// BrandStreamRequest e BrandStreamResponse trafegam no EnrichStream; a tag
+// liga cada resposta ao pedido que a originou
+type BrandStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           uint64                 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandStreamRequest) Reset() {
+	*x = BrandStreamRequest{}
+	mi := &file_proto_brand_brand_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandStreamRequest) ProtoMessage() {}
+
+func (x *BrandStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_brand_brand_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandStreamRequest.ProtoReflect.Descriptor instead.
+func (*BrandStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_brand_brand_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BrandStreamRequest) GetTag() uint64 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *BrandStreamRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BrandStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tag   uint64                 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Brand *Brand                 `protobuf:"bytes,2,opt,name=brand,proto3" json:"brand,omitempty"`
+	// error e code vêm preenchidos quando a busca falha; code é o código
+	// gRPC que a chamada unária devolveria
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Code          int32  `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandStreamResponse) Reset() {
+	*x = BrandStreamResponse{}
+	mi := &file_proto_brand_brand_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandStreamResponse) ProtoMessage() {}
+
+func (x *BrandStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_brand_brand_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandStreamResponse.ProtoReflect.Descriptor instead.
+func (*BrandStreamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_brand_brand_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BrandStreamResponse) GetTag() uint64 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *BrandStreamResponse) GetBrand() *Brand {
+	if x != nil {
+		return x.Brand
+	}
+	return nil
+}
+
+func (x *BrandStreamResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BrandStreamResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+// PatchBrandRequest altera só os campos de update_mask, com os valores de
+// brand; sem máscara, altera os campos preenchidos
+type PatchBrandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Brand         *Brand                 `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchBrandRequest) Reset() {
+	*x = PatchBrandRequest{}
+	mi := &file_proto_brand_brand_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchBrandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchBrandRequest) ProtoMessage() {}
+
+func (x *PatchBrandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_brand_brand_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchBrandRequest.ProtoReflect.Descriptor instead.
+func (*PatchBrandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_brand_brand_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PatchBrandRequest) GetBrand() *Brand {
+	if x != nil {
+		return x.Brand
+	}
+	return nil
+}
+
+func (x *PatchBrandRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+var File_proto_brand_brand_proto protoreflect.FileDescriptor
+
+const file_proto_brand_brand_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/brand/brand.proto\x12\x05proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\x7f\n" +
+	"\x05Brand\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\"\x1e\n" +
+	"\fBrandRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"1\n" +
+	"\tBrandList\x12$\n" +
+	"\x06brands\x18\x01 \x03(\v2\f.proto.BrandR\x06brands\"6\n" +
+	"\x12BrandStreamRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\x04R\x03tag\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"u\n" +
+	"\x13BrandStreamResponse\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\x04R\x03tag\x12\"\n" +
+	"\x05brand\x18\x02 \x01(\v2\f.proto.BrandR\x05brand\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\x05R\x04code\"t\n" +
+	"\x11PatchBrandRequest\x12\"\n" +
+	"\x05brand\x18\x01 \x01(\v2\f.proto.BrandR\x05brand\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask2\xc6\x03\n" +
+	"\fBrandService\x128\n" +
+	"\fGetAllBrands\x12\x16.google.protobuf.Empty\x1a\x10.proto.BrandList\x126\n" +
+	"\fStreamBrands\x12\x16.google.protobuf.Empty\x1a\f.proto.Brand0\x01\x121\n" +
+	"\fGetBrandByID\x12\x13.proto.BrandRequest\x1a\f.proto.Brand\x12I\n" +
+	"\fEnrichStream\x12\x19.proto.BrandStreamRequest\x1a\x1a.proto.BrandStreamResponse(\x010\x01\x12)\n" +
+	"\vCreateBrand\x12\f.proto.Brand\x1a\f.proto.Brand\x12)\n" +
+	"\vUpdateBrand\x12\f.proto.Brand\x1a\f.proto.Brand\x124\n" +
+	"\n" +
+	"PatchBrand\x12\x18.proto.PatchBrandRequest\x1a\f.proto.Brand\x12:\n" +
+	"\vDeleteBrand\x12\x13.proto.BrandRequest\x1a\x16.google.protobuf.EmptyB\x17Z\x15./proto/brand;brandpbb\x06proto3"
+
+var (
+	file_proto_brand_brand_proto_rawDescOnce sync.Once
+	file_proto_brand_brand_proto_rawDescData []byte
+)
+
+func file_proto_brand_brand_proto_rawDescGZIP() []byte {
+	file_proto_brand_brand_proto_rawDescOnce.Do(func() {
+		file_proto_brand_brand_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_brand_brand_proto_rawDesc), len(file_proto_brand_brand_proto_rawDesc)))
+	})
+	return file_proto_brand_brand_proto_rawDescData
+}
+
+var file_proto_brand_brand_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_brand_brand_proto_goTypes = []any{
+	(*Brand)(nil),                 // 0: proto.Brand
+	(*BrandRequest)(nil),          // 1: proto.BrandRequest
+	(*BrandList)(nil),             // 2: proto.BrandList
+	(*BrandStreamRequest)(nil),    // 3: proto.BrandStreamRequest
+	(*BrandStreamResponse)(nil),   // 4: proto.BrandStreamResponse
+	(*PatchBrandRequest)(nil),     // 5: proto.PatchBrandRequest
+	(*fieldmaskpb.FieldMask)(nil), // 6: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 7: google.protobuf.Empty
+}
+var file_proto_brand_brand_proto_depIdxs = []int32{
+	0,  // 0: proto.BrandList.brands:type_name -> proto.Brand
+	0,  // 1: proto.BrandStreamResponse.brand:type_name -> proto.Brand
+	0,  // 2: proto.PatchBrandRequest.brand:type_name -> proto.Brand
+	6,  // 3: proto.PatchBrandRequest.update_mask:type_name -> google.protobuf.FieldMask
+	7,  // 4: proto.BrandService.GetAllBrands:input_type -> google.protobuf.Empty
+	7,  // 5: proto.BrandService.StreamBrands:input_type -> google.protobuf.Empty
+	1,  // 6: proto.BrandService.GetBrandByID:input_type -> proto.BrandRequest
+	3,  // 7: proto.BrandService.EnrichStream:input_type -> proto.BrandStreamRequest
+	0,  // 8: proto.BrandService.CreateBrand:input_type -> proto.Brand
+	0,  // 9: proto.BrandService.UpdateBrand:input_type -> proto.Brand
+	5,  // 10: proto.BrandService.PatchBrand:input_type -> proto.PatchBrandRequest
+	1,  // 11: proto.BrandService.DeleteBrand:input_type -> proto.BrandRequest
+	2,  // 12: proto.BrandService.GetAllBrands:output_type -> proto.BrandList
+	0,  // 13: proto.BrandService.StreamBrands:output_type -> proto.Brand
+	0,  // 14: proto.BrandService.GetBrandByID:output_type -> proto.Brand
+	4,  // 15: proto.BrandService.EnrichStream:output_type -> proto.BrandStreamResponse
+	0,  // 16: proto.BrandService.CreateBrand:output_type -> proto.Brand
+	0,  // 17: proto.BrandService.UpdateBrand:output_type -> proto.Brand
+	0,  // 18: proto.BrandService.PatchBrand:output_type -> proto.Brand
+	7,  // 19: proto.BrandService.DeleteBrand:output_type -> google.protobuf.Empty
+	12, // [12:20] is the sub-list for method output_type
+	4,  // [4:12] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_brand_brand_proto_init() }
+func file_proto_brand_brand_proto_init() {
+	if File_proto_brand_brand_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_brand_brand_proto_rawDesc), len(file_proto_brand_brand_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_brand_brand_proto_goTypes,
+		DependencyIndexes: file_proto_brand_brand_proto_depIdxs,
+		MessageInfos:      file_proto_brand_brand_proto_msgTypes,
+	}.Build()
+	File_proto_brand_brand_proto = out.File
+	file_proto_brand_brand_proto_goTypes = nil
+	file_proto_brand_brand_proto_depIdxs = nil
+}
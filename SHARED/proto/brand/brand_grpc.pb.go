@@ -0,0 +1,398 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/brand/brand.proto
+
+package brandpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BrandService_GetAllBrands_FullMethodName = "/proto.BrandService/GetAllBrands"
+	BrandService_StreamBrands_FullMethodName = "/proto.BrandService/StreamBrands"
+	BrandService_GetBrandByID_FullMethodName = "/proto.BrandService/GetBrandByID"
+	BrandService_EnrichStream_FullMethodName = "/proto.BrandService/EnrichStream"
+	BrandService_CreateBrand_FullMethodName  = "/proto.BrandService/CreateBrand"
+	BrandService_UpdateBrand_FullMethodName  = "/proto.BrandService/UpdateBrand"
+	BrandService_PatchBrand_FullMethodName   = "/proto.BrandService/PatchBrand"
+	BrandService_DeleteBrand_FullMethodName  = "/proto.BrandService/DeleteBrand"
+)
+
+// BrandServiceClient is the client API for BrandService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BrandServiceClient interface {
+	GetAllBrands(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BrandList, error)
+	// StreamBrands envia um Brand por mensagem, sem montar a lista inteira
+	StreamBrands(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Brand], error)
+	GetBrandByID(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*Brand, error)
+	// EnrichStream atende pedidos por ID em um único stream de longa duração;
+	// as respostas podem chegar fora da ordem dos pedidos
+	EnrichStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BrandStreamRequest, BrandStreamResponse], error)
+	// As escritas validam o registro como as stacks HTTP. CreateBrand ignora o
+	// id recebido e devolve o atribuído; UpdateBrand e PatchBrand usam o id para
+	// achar o registro
+	CreateBrand(ctx context.Context, in *Brand, opts ...grpc.CallOption) (*Brand, error)
+	UpdateBrand(ctx context.Context, in *Brand, opts ...grpc.CallOption) (*Brand, error)
+	PatchBrand(ctx context.Context, in *PatchBrandRequest, opts ...grpc.CallOption) (*Brand, error)
+	DeleteBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type brandServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBrandServiceClient(cc grpc.ClientConnInterface) BrandServiceClient {
+	return &brandServiceClient{cc}
+}
+
+func (c *brandServiceClient) GetAllBrands(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BrandList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BrandList)
+	err := c.cc.Invoke(ctx, BrandService_GetAllBrands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brandServiceClient) StreamBrands(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Brand], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BrandService_ServiceDesc.Streams[0], BrandService_StreamBrands_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, Brand]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BrandService_StreamBrandsClient = grpc.ServerStreamingClient[Brand]
+
+func (c *brandServiceClient) GetBrandByID(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*Brand, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Brand)
+	err := c.cc.Invoke(ctx, BrandService_GetBrandByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brandServiceClient) EnrichStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BrandStreamRequest, BrandStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BrandService_ServiceDesc.Streams[1], BrandService_EnrichStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BrandStreamRequest, BrandStreamResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BrandService_EnrichStreamClient = grpc.BidiStreamingClient[BrandStreamRequest, BrandStreamResponse]
+
+func (c *brandServiceClient) CreateBrand(ctx context.Context, in *Brand, opts ...grpc.CallOption) (*Brand, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Brand)
+	err := c.cc.Invoke(ctx, BrandService_CreateBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brandServiceClient) UpdateBrand(ctx context.Context, in *Brand, opts ...grpc.CallOption) (*Brand, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Brand)
+	err := c.cc.Invoke(ctx, BrandService_UpdateBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brandServiceClient) PatchBrand(ctx context.Context, in *PatchBrandRequest, opts ...grpc.CallOption) (*Brand, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Brand)
+	err := c.cc.Invoke(ctx, BrandService_PatchBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brandServiceClient) DeleteBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BrandService_DeleteBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BrandServiceServer is the server API for BrandService service.
+// All implementations must embed UnimplementedBrandServiceServer
+// for forward compatibility.
+type BrandServiceServer interface {
+	GetAllBrands(context.Context, *emptypb.Empty) (*BrandList, error)
+	// StreamBrands envia um Brand por mensagem, sem montar a lista inteira
+	StreamBrands(*emptypb.Empty, grpc.ServerStreamingServer[Brand]) error
+	GetBrandByID(context.Context, *BrandRequest) (*Brand, error)
+	// EnrichStream atende pedidos por ID em um único stream de longa duração;
+	// as respostas podem chegar fora da ordem dos pedidos
+	EnrichStream(grpc.BidiStreamingServer[BrandStreamRequest, BrandStreamResponse]) error
+	// As escritas validam o registro como as stacks HTTP. CreateBrand ignora o
+	// id recebido e devolve o atribuído; UpdateBrand e PatchBrand usam o id para
+	// achar o registro
+	CreateBrand(context.Context, *Brand) (*Brand, error)
+	UpdateBrand(context.Context, *Brand) (*Brand, error)
+	PatchBrand(context.Context, *PatchBrandRequest) (*Brand, error)
+	DeleteBrand(context.Context, *BrandRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedBrandServiceServer()
+}
+
+// UnimplementedBrandServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBrandServiceServer struct{}
+
+func (UnimplementedBrandServiceServer) GetAllBrands(context.Context, *emptypb.Empty) (*BrandList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllBrands not implemented")
+}
+func (UnimplementedBrandServiceServer) StreamBrands(*emptypb.Empty, grpc.ServerStreamingServer[Brand]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBrands not implemented")
+}
+func (UnimplementedBrandServiceServer) GetBrandByID(context.Context, *BrandRequest) (*Brand, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBrandByID not implemented")
+}
+func (UnimplementedBrandServiceServer) EnrichStream(grpc.BidiStreamingServer[BrandStreamRequest, BrandStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method EnrichStream not implemented")
+}
+func (UnimplementedBrandServiceServer) CreateBrand(context.Context, *Brand) (*Brand, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBrand not implemented")
+}
+func (UnimplementedBrandServiceServer) UpdateBrand(context.Context, *Brand) (*Brand, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBrand not implemented")
+}
+func (UnimplementedBrandServiceServer) PatchBrand(context.Context, *PatchBrandRequest) (*Brand, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchBrand not implemented")
+}
+func (UnimplementedBrandServiceServer) DeleteBrand(context.Context, *BrandRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBrand not implemented")
+}
+func (UnimplementedBrandServiceServer) mustEmbedUnimplementedBrandServiceServer() {}
+func (UnimplementedBrandServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeBrandServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BrandServiceServer will
+// result in compilation errors.
+type UnsafeBrandServiceServer interface {
+	mustEmbedUnimplementedBrandServiceServer()
+}
+
+func RegisterBrandServiceServer(s grpc.ServiceRegistrar, srv BrandServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBrandServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BrandService_ServiceDesc, srv)
+}
+
+func _BrandService_GetAllBrands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrandServiceServer).GetAllBrands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrandService_GetAllBrands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrandServiceServer).GetAllBrands(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrandService_StreamBrands_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BrandServiceServer).StreamBrands(m, &grpc.GenericServerStream[emptypb.Empty, Brand]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BrandService_StreamBrandsServer = grpc.ServerStreamingServer[Brand]
+
+func _BrandService_GetBrandByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrandServiceServer).GetBrandByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrandService_GetBrandByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrandServiceServer).GetBrandByID(ctx, req.(*BrandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrandService_EnrichStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BrandServiceServer).EnrichStream(&grpc.GenericServerStream[BrandStreamRequest, BrandStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BrandService_EnrichStreamServer = grpc.BidiStreamingServer[BrandStreamRequest, BrandStreamResponse]
+
+func _BrandService_CreateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Brand)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrandServiceServer).CreateBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrandService_CreateBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrandServiceServer).CreateBrand(ctx, req.(*Brand))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrandService_UpdateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Brand)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrandServiceServer).UpdateBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrandService_UpdateBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrandServiceServer).UpdateBrand(ctx, req.(*Brand))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrandService_PatchBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchBrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrandServiceServer).PatchBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrandService_PatchBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrandServiceServer).PatchBrand(ctx, req.(*PatchBrandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrandService_DeleteBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrandServiceServer).DeleteBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrandService_DeleteBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrandServiceServer).DeleteBrand(ctx, req.(*BrandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BrandService_ServiceDesc is the grpc.ServiceDesc for BrandService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BrandService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.BrandService",
+	HandlerType: (*BrandServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAllBrands",
+			Handler:    _BrandService_GetAllBrands_Handler,
+		},
+		{
+			MethodName: "GetBrandByID",
+			Handler:    _BrandService_GetBrandByID_Handler,
+		},
+		{
+			MethodName: "CreateBrand",
+			Handler:    _BrandService_CreateBrand_Handler,
+		},
+		{
+			MethodName: "UpdateBrand",
+			Handler:    _BrandService_UpdateBrand_Handler,
+		},
+		{
+			MethodName: "PatchBrand",
+			Handler:    _BrandService_PatchBrand_Handler,
+		},
+		{
+			MethodName: "DeleteBrand",
+			Handler:    _BrandService_DeleteBrand_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBrands",
+			Handler:       _BrandService_StreamBrands_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "EnrichStream",
+			Handler:       _BrandService_EnrichStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/brand/brand.proto",
+}
@@ -0,0 +1,31 @@
+package brandpb
+
+import "shared/domain"
+
+func (b *Brand) ToDomain() *domain.Brand {
+	return &domain.Brand{
+		ID:          int(b.Id),
+		Name:        b.Name,
+		Description: b.Description,
+		Country:     b.Country,
+		Active:      b.Active,
+	}
+}
+
+func FromDomain(b domain.Brand) *Brand {
+	return &Brand{
+		Id:          int32(b.ID),
+		Name:        b.Name,
+		Description: b.Description,
+		Country:     b.Country,
+		Active:      b.Active,
+	}
+}
+
+func ListFromDomain(brands []domain.Brand) *BrandList {
+	list := &BrandList{}
+	for _, b := range brands {
+		list.Brands = append(list.Brands, FromDomain(b))
+	}
+	return list
+}
@@ -0,0 +1,504 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: proto/category/category.proto
+
+package categorypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_proto_category_category_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_category_category_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_proto_category_category_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Category) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CategoryId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryId) Reset() {
+	*x = CategoryId{}
+	mi := &file_proto_category_category_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryId) ProtoMessage() {}
+
+func (x *CategoryId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_category_category_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryId.ProtoReflect.Descriptor instead.
+func (*CategoryId) Descriptor() ([]byte, []int) {
+	return file_proto_category_category_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CategoryId) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CategoryIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int32                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryIds) Reset() {
+	*x = CategoryIds{}
+	mi := &file_proto_category_category_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryIds) ProtoMessage() {}
+
+func (x *CategoryIds) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_category_category_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryIds.ProtoReflect.Descriptor instead.
+func (*CategoryIds) Descriptor() ([]byte, []int) {
+	return file_proto_category_category_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CategoryIds) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type CategoryList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryList) Reset() {
+	*x = CategoryList{}
+	mi := &file_proto_category_category_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryList) ProtoMessage() {}
+
+func (x *CategoryList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_category_category_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryList.ProtoReflect.Descriptor instead.
+func (*CategoryList) Descriptor() ([]byte, []int) {
+	return file_proto_category_category_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CategoryList) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// CategoryStreamRequest e CategoryStreamResponse trafegam no EnrichStream; a tag
+// liga cada resposta ao pedido que a originou
+type CategoryStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           uint64                 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryStreamRequest) Reset() {
+	*x = CategoryStreamRequest{}
+	mi := &file_proto_category_category_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryStreamRequest) ProtoMessage() {}
+
+func (x *CategoryStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_category_category_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryStreamRequest.ProtoReflect.Descriptor instead.
+func (*CategoryStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_category_category_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CategoryStreamRequest) GetTag() uint64 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *CategoryStreamRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CategoryStreamResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Tag      uint64                 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Category *Category              `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	// error e code vêm preenchidos quando a busca falha; code é o código
+	// gRPC que a chamada unária devolveria
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Code          int32  `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryStreamResponse) Reset() {
+	*x = CategoryStreamResponse{}
+	mi := &file_proto_category_category_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryStreamResponse) ProtoMessage() {}
+
+func (x *CategoryStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_category_category_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryStreamResponse.ProtoReflect.Descriptor instead.
+func (*CategoryStreamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_category_category_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CategoryStreamResponse) GetTag() uint64 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *CategoryStreamResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryStreamResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CategoryStreamResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+// PatchCategoryRequest altera só os campos de update_mask, com os valores de
+// category; sem máscara, altera os campos preenchidos
+type PatchCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchCategoryRequest) Reset() {
+	*x = PatchCategoryRequest{}
+	mi := &file_proto_category_category_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchCategoryRequest) ProtoMessage() {}
+
+func (x *PatchCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_category_category_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchCategoryRequest.ProtoReflect.Descriptor instead.
+func (*PatchCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_category_category_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PatchCategoryRequest) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *PatchCategoryRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+var File_proto_category_category_proto protoreflect.FileDescriptor
+
+const file_proto_category_category_proto_rawDesc = "" +
+	"\n" +
+	"\x1dproto/category/category.proto\x12\x05proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\".\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x1c\n" +
+	"\n" +
+	"CategoryId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x1f\n" +
+	"\vCategoryIds\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x05R\x03ids\"?\n" +
+	"\fCategoryList\x12/\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x0f.proto.CategoryR\n" +
+	"categories\"9\n" +
+	"\x15CategoryStreamRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\x04R\x03tag\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"\x81\x01\n" +
+	"\x16CategoryStreamResponse\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\x04R\x03tag\x12+\n" +
+	"\bcategory\x18\x02 \x01(\v2\x0f.proto.CategoryR\bcategory\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\x05R\x04code\"\x80\x01\n" +
+	"\x14PatchCategoryRequest\x12+\n" +
+	"\bcategory\x18\x01 \x01(\v2\x0f.proto.CategoryR\bcategory\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask2\xbc\x04\n" +
+	"\x0fCategoryService\x12?\n" +
+	"\x10GetAllCategories\x12\x16.google.protobuf.Empty\x1a\x13.proto.CategoryList\x12=\n" +
+	"\x10StreamCategories\x12\x16.google.protobuf.Empty\x1a\x0f.proto.Category0\x01\x125\n" +
+	"\x0fGetCategoryByID\x12\x11.proto.CategoryId\x1a\x0f.proto.Category\x12=\n" +
+	"\x12GetCategoriesByIDs\x12\x12.proto.CategoryIds\x1a\x13.proto.CategoryList\x12O\n" +
+	"\fEnrichStream\x12\x1c.proto.CategoryStreamRequest\x1a\x1d.proto.CategoryStreamResponse(\x010\x01\x122\n" +
+	"\x0eCreateCategory\x12\x0f.proto.Category\x1a\x0f.proto.Category\x122\n" +
+	"\x0eUpdateCategory\x12\x0f.proto.Category\x1a\x0f.proto.Category\x12=\n" +
+	"\rPatchCategory\x12\x1b.proto.PatchCategoryRequest\x1a\x0f.proto.Category\x12;\n" +
+	"\x0eDeleteCategory\x12\x11.proto.CategoryId\x1a\x16.google.protobuf.EmptyB\x1dZ\x1b./proto/category;categorypbb\x06proto3"
+
+var (
+	file_proto_category_category_proto_rawDescOnce sync.Once
+	file_proto_category_category_proto_rawDescData []byte
+)
+
+func file_proto_category_category_proto_rawDescGZIP() []byte {
+	file_proto_category_category_proto_rawDescOnce.Do(func() {
+		file_proto_category_category_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_category_category_proto_rawDesc), len(file_proto_category_category_proto_rawDesc)))
+	})
+	return file_proto_category_category_proto_rawDescData
+}
+
+var file_proto_category_category_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_category_category_proto_goTypes = []any{
+	(*Category)(nil),               // 0: proto.Category
+	(*CategoryId)(nil),             // 1: proto.CategoryId
+	(*CategoryIds)(nil),            // 2: proto.CategoryIds
+	(*CategoryList)(nil),           // 3: proto.CategoryList
+	(*CategoryStreamRequest)(nil),  // 4: proto.CategoryStreamRequest
+	(*CategoryStreamResponse)(nil), // 5: proto.CategoryStreamResponse
+	(*PatchCategoryRequest)(nil),   // 6: proto.PatchCategoryRequest
+	(*fieldmaskpb.FieldMask)(nil),  // 7: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),          // 8: google.protobuf.Empty
+}
+var file_proto_category_category_proto_depIdxs = []int32{
+	0,  // 0: proto.CategoryList.categories:type_name -> proto.Category
+	0,  // 1: proto.CategoryStreamResponse.category:type_name -> proto.Category
+	0,  // 2: proto.PatchCategoryRequest.category:type_name -> proto.Category
+	7,  // 3: proto.PatchCategoryRequest.update_mask:type_name -> google.protobuf.FieldMask
+	8,  // 4: proto.CategoryService.GetAllCategories:input_type -> google.protobuf.Empty
+	8,  // 5: proto.CategoryService.StreamCategories:input_type -> google.protobuf.Empty
+	1,  // 6: proto.CategoryService.GetCategoryByID:input_type -> proto.CategoryId
+	2,  // 7: proto.CategoryService.GetCategoriesByIDs:input_type -> proto.CategoryIds
+	4,  // 8: proto.CategoryService.EnrichStream:input_type -> proto.CategoryStreamRequest
+	0,  // 9: proto.CategoryService.CreateCategory:input_type -> proto.Category
+	0,  // 10: proto.CategoryService.UpdateCategory:input_type -> proto.Category
+	6,  // 11: proto.CategoryService.PatchCategory:input_type -> proto.PatchCategoryRequest
+	1,  // 12: proto.CategoryService.DeleteCategory:input_type -> proto.CategoryId
+	3,  // 13: proto.CategoryService.GetAllCategories:output_type -> proto.CategoryList
+	0,  // 14: proto.CategoryService.StreamCategories:output_type -> proto.Category
+	0,  // 15: proto.CategoryService.GetCategoryByID:output_type -> proto.Category
+	3,  // 16: proto.CategoryService.GetCategoriesByIDs:output_type -> proto.CategoryList
+	5,  // 17: proto.CategoryService.EnrichStream:output_type -> proto.CategoryStreamResponse
+	0,  // 18: proto.CategoryService.CreateCategory:output_type -> proto.Category
+	0,  // 19: proto.CategoryService.UpdateCategory:output_type -> proto.Category
+	0,  // 20: proto.CategoryService.PatchCategory:output_type -> proto.Category
+	8,  // 21: proto.CategoryService.DeleteCategory:output_type -> google.protobuf.Empty
+	13, // [13:22] is the sub-list for method output_type
+	4,  // [4:13] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_category_category_proto_init() }
+func file_proto_category_category_proto_init() {
+	if File_proto_category_category_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_category_category_proto_rawDesc), len(file_proto_category_category_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_category_category_proto_goTypes,
+		DependencyIndexes: file_proto_category_category_proto_depIdxs,
+		MessageInfos:      file_proto_category_category_proto_msgTypes,
+	}.Build()
+	File_proto_category_category_proto = out.File
+	file_proto_category_category_proto_goTypes = nil
+	file_proto_category_category_proto_depIdxs = nil
+}
@@ -0,0 +1,28 @@
+package categorypb
+
+import "shared/domain"
+
+func (c *Category) ToDomain() *domain.Category {
+	return &domain.Category{ID: int(c.Id), Name: c.Name}
+}
+
+// ToDomain devolve uma lista vazia, nunca nil, quando não há categorias
+func (l *CategoryList) ToDomain() []*domain.Category {
+	categories := make([]*domain.Category, len(l.Categories))
+	for i, c := range l.Categories {
+		categories[i] = c.ToDomain()
+	}
+	return categories
+}
+
+func FromDomain(c domain.Category) *Category {
+	return &Category{Id: int32(c.ID), Name: c.Name}
+}
+
+func ListFromDomain(categories []domain.Category) *CategoryList {
+	list := &CategoryList{}
+	for _, c := range categories {
+		list.Categories = append(list.Categories, FromDomain(c))
+	}
+	return list
+}
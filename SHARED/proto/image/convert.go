@@ -0,0 +1,28 @@
+package imagepb
+
+import "shared/domain"
+
+func (i *Image) ToDomain() *domain.Image {
+	return &domain.Image{ID: int(i.Id), URL: i.Url}
+}
+
+// ToDomain devolve uma lista vazia, nunca nil, quando não há imagens
+func (l *ImageList) ToDomain() []*domain.Image {
+	images := make([]*domain.Image, len(l.Images))
+	for i, image := range l.Images {
+		images[i] = image.ToDomain()
+	}
+	return images
+}
+
+func FromDomain(i domain.Image) *Image {
+	return &Image{Id: int32(i.ID), Url: i.URL}
+}
+
+func ListFromDomain(images []domain.Image) *ImageList {
+	list := &ImageList{}
+	for _, i := range images {
+		list.Images = append(list.Images, FromDomain(i))
+	}
+	return list
+}
@@ -0,0 +1,502 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: proto/image/image.proto
+
+package imagepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Image struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Image) Reset() {
+	*x = Image{}
+	mi := &file_proto_image_image_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Image) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Image) ProtoMessage() {}
+
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_image_image_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_proto_image_image_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Image) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Image) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ImageId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageId) Reset() {
+	*x = ImageId{}
+	mi := &file_proto_image_image_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageId) ProtoMessage() {}
+
+func (x *ImageId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_image_image_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageId.ProtoReflect.Descriptor instead.
+func (*ImageId) Descriptor() ([]byte, []int) {
+	return file_proto_image_image_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImageId) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ImageIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int32                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageIds) Reset() {
+	*x = ImageIds{}
+	mi := &file_proto_image_image_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageIds) ProtoMessage() {}
+
+func (x *ImageIds) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_image_image_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageIds.ProtoReflect.Descriptor instead.
+func (*ImageIds) Descriptor() ([]byte, []int) {
+	return file_proto_image_image_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImageIds) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ImageList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*Image               `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageList) Reset() {
+	*x = ImageList{}
+	mi := &file_proto_image_image_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageList) ProtoMessage() {}
+
+func (x *ImageList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_image_image_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageList.ProtoReflect.Descriptor instead.
+func (*ImageList) Descriptor() ([]byte, []int) {
+	return file_proto_image_image_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImageList) GetImages() []*Image {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// ImageStreamRequest e ImageStreamResponse trafegam no EnrichStream; a tag
+// liga cada resposta ao pedido que a originou
+type ImageStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           uint64                 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageStreamRequest) Reset() {
+	*x = ImageStreamRequest{}
+	mi := &file_proto_image_image_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageStreamRequest) ProtoMessage() {}
+
+func (x *ImageStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_image_image_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageStreamRequest.ProtoReflect.Descriptor instead.
+func (*ImageStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_image_image_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ImageStreamRequest) GetTag() uint64 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *ImageStreamRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ImageStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tag   uint64                 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Image *Image                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	// error e code vêm preenchidos quando a busca falha; code é o código
+	// gRPC que a chamada unária devolveria
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Code          int32  `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageStreamResponse) Reset() {
+	*x = ImageStreamResponse{}
+	mi := &file_proto_image_image_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageStreamResponse) ProtoMessage() {}
+
+func (x *ImageStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_image_image_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageStreamResponse.ProtoReflect.Descriptor instead.
+func (*ImageStreamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_image_image_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ImageStreamResponse) GetTag() uint64 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *ImageStreamResponse) GetImage() *Image {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *ImageStreamResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImageStreamResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+// PatchImageRequest altera só os campos de update_mask, com os valores de
+// image; sem máscara, altera os campos preenchidos
+type PatchImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         *Image                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchImageRequest) Reset() {
+	*x = PatchImageRequest{}
+	mi := &file_proto_image_image_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchImageRequest) ProtoMessage() {}
+
+func (x *PatchImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_image_image_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchImageRequest.ProtoReflect.Descriptor instead.
+func (*PatchImageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_image_image_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PatchImageRequest) GetImage() *Image {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *PatchImageRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+var File_proto_image_image_proto protoreflect.FileDescriptor
+
+const file_proto_image_image_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/image/image.proto\x12\x05proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\")\n" +
+	"\x05Image\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\x19\n" +
+	"\aImageId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x1c\n" +
+	"\bImageIds\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x05R\x03ids\"1\n" +
+	"\tImageList\x12$\n" +
+	"\x06images\x18\x01 \x03(\v2\f.proto.ImageR\x06images\"6\n" +
+	"\x12ImageStreamRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\x04R\x03tag\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"u\n" +
+	"\x13ImageStreamResponse\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\x04R\x03tag\x12\"\n" +
+	"\x05image\x18\x02 \x01(\v2\f.proto.ImageR\x05image\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\x05R\x04code\"t\n" +
+	"\x11PatchImageRequest\x12\"\n" +
+	"\x05image\x18\x01 \x01(\v2\f.proto.ImageR\x05image\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask2\xf1\x03\n" +
+	"\fImageService\x128\n" +
+	"\fGetAllImages\x12\x16.google.protobuf.Empty\x1a\x10.proto.ImageList\x126\n" +
+	"\fStreamImages\x12\x16.google.protobuf.Empty\x1a\f.proto.Image0\x01\x12,\n" +
+	"\fGetImageByID\x12\x0e.proto.ImageId\x1a\f.proto.Image\x123\n" +
+	"\x0eGetImagesByIDs\x12\x0f.proto.ImageIds\x1a\x10.proto.ImageList\x12I\n" +
+	"\fEnrichStream\x12\x19.proto.ImageStreamRequest\x1a\x1a.proto.ImageStreamResponse(\x010\x01\x12)\n" +
+	"\vCreateImage\x12\f.proto.Image\x1a\f.proto.Image\x12)\n" +
+	"\vUpdateImage\x12\f.proto.Image\x1a\f.proto.Image\x124\n" +
+	"\n" +
+	"PatchImage\x12\x18.proto.PatchImageRequest\x1a\f.proto.Image\x125\n" +
+	"\vDeleteImage\x12\x0e.proto.ImageId\x1a\x16.google.protobuf.EmptyB\x17Z\x15./proto/image;imagepbb\x06proto3"
+
+var (
+	file_proto_image_image_proto_rawDescOnce sync.Once
+	file_proto_image_image_proto_rawDescData []byte
+)
+
+func file_proto_image_image_proto_rawDescGZIP() []byte {
+	file_proto_image_image_proto_rawDescOnce.Do(func() {
+		file_proto_image_image_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_image_image_proto_rawDesc), len(file_proto_image_image_proto_rawDesc)))
+	})
+	return file_proto_image_image_proto_rawDescData
+}
+
+var file_proto_image_image_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_image_image_proto_goTypes = []any{
+	(*Image)(nil),                 // 0: proto.Image
+	(*ImageId)(nil),               // 1: proto.ImageId
+	(*ImageIds)(nil),              // 2: proto.ImageIds
+	(*ImageList)(nil),             // 3: proto.ImageList
+	(*ImageStreamRequest)(nil),    // 4: proto.ImageStreamRequest
+	(*ImageStreamResponse)(nil),   // 5: proto.ImageStreamResponse
+	(*PatchImageRequest)(nil),     // 6: proto.PatchImageRequest
+	(*fieldmaskpb.FieldMask)(nil), // 7: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+}
+var file_proto_image_image_proto_depIdxs = []int32{
+	0,  // 0: proto.ImageList.images:type_name -> proto.Image
+	0,  // 1: proto.ImageStreamResponse.image:type_name -> proto.Image
+	0,  // 2: proto.PatchImageRequest.image:type_name -> proto.Image
+	7,  // 3: proto.PatchImageRequest.update_mask:type_name -> google.protobuf.FieldMask
+	8,  // 4: proto.ImageService.GetAllImages:input_type -> google.protobuf.Empty
+	8,  // 5: proto.ImageService.StreamImages:input_type -> google.protobuf.Empty
+	1,  // 6: proto.ImageService.GetImageByID:input_type -> proto.ImageId
+	2,  // 7: proto.ImageService.GetImagesByIDs:input_type -> proto.ImageIds
+	4,  // 8: proto.ImageService.EnrichStream:input_type -> proto.ImageStreamRequest
+	0,  // 9: proto.ImageService.CreateImage:input_type -> proto.Image
+	0,  // 10: proto.ImageService.UpdateImage:input_type -> proto.Image
+	6,  // 11: proto.ImageService.PatchImage:input_type -> proto.PatchImageRequest
+	1,  // 12: proto.ImageService.DeleteImage:input_type -> proto.ImageId
+	3,  // 13: proto.ImageService.GetAllImages:output_type -> proto.ImageList
+	0,  // 14: proto.ImageService.StreamImages:output_type -> proto.Image
+	0,  // 15: proto.ImageService.GetImageByID:output_type -> proto.Image
+	3,  // 16: proto.ImageService.GetImagesByIDs:output_type -> proto.ImageList
+	5,  // 17: proto.ImageService.EnrichStream:output_type -> proto.ImageStreamResponse
+	0,  // 18: proto.ImageService.CreateImage:output_type -> proto.Image
+	0,  // 19: proto.ImageService.UpdateImage:output_type -> proto.Image
+	0,  // 20: proto.ImageService.PatchImage:output_type -> proto.Image
+	8,  // 21: proto.ImageService.DeleteImage:output_type -> google.protobuf.Empty
+	13, // [13:22] is the sub-list for method output_type
+	4,  // [4:13] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_image_image_proto_init() }
+func file_proto_image_image_proto_init() {
+	if File_proto_image_image_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_image_image_proto_rawDesc), len(file_proto_image_image_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_image_image_proto_goTypes,
+		DependencyIndexes: file_proto_image_image_proto_depIdxs,
+		MessageInfos:      file_proto_image_image_proto_msgTypes,
+	}.Build()
+	File_proto_image_image_proto = out.File
+	file_proto_image_image_proto_goTypes = nil
+	file_proto_image_image_proto_depIdxs = nil
+}
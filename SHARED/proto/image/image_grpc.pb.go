@@ -0,0 +1,436 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/image/image.proto
+
+package imagepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ImageService_GetAllImages_FullMethodName   = "/proto.ImageService/GetAllImages"
+	ImageService_StreamImages_FullMethodName   = "/proto.ImageService/StreamImages"
+	ImageService_GetImageByID_FullMethodName   = "/proto.ImageService/GetImageByID"
+	ImageService_GetImagesByIDs_FullMethodName = "/proto.ImageService/GetImagesByIDs"
+	ImageService_EnrichStream_FullMethodName   = "/proto.ImageService/EnrichStream"
+	ImageService_CreateImage_FullMethodName    = "/proto.ImageService/CreateImage"
+	ImageService_UpdateImage_FullMethodName    = "/proto.ImageService/UpdateImage"
+	ImageService_PatchImage_FullMethodName     = "/proto.ImageService/PatchImage"
+	ImageService_DeleteImage_FullMethodName    = "/proto.ImageService/DeleteImage"
+)
+
+// ImageServiceClient is the client API for ImageService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ImageServiceClient interface {
+	GetAllImages(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ImageList, error)
+	// StreamImages envia um Image por mensagem, sem montar a lista inteira
+	StreamImages(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Image], error)
+	GetImageByID(ctx context.Context, in *ImageId, opts ...grpc.CallOption) (*Image, error)
+	GetImagesByIDs(ctx context.Context, in *ImageIds, opts ...grpc.CallOption) (*ImageList, error)
+	// EnrichStream atende pedidos por ID em um único stream de longa duração;
+	// as respostas podem chegar fora da ordem dos pedidos
+	EnrichStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImageStreamRequest, ImageStreamResponse], error)
+	// As escritas validam o registro como as stacks HTTP. CreateImage ignora o
+	// id recebido e devolve o atribuído; UpdateImage e PatchImage usam o id para
+	// achar o registro
+	CreateImage(ctx context.Context, in *Image, opts ...grpc.CallOption) (*Image, error)
+	UpdateImage(ctx context.Context, in *Image, opts ...grpc.CallOption) (*Image, error)
+	PatchImage(ctx context.Context, in *PatchImageRequest, opts ...grpc.CallOption) (*Image, error)
+	DeleteImage(ctx context.Context, in *ImageId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type imageServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewImageServiceClient(cc grpc.ClientConnInterface) ImageServiceClient {
+	return &imageServiceClient{cc}
+}
+
+func (c *imageServiceClient) GetAllImages(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ImageList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImageList)
+	err := c.cc.Invoke(ctx, ImageService_GetAllImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) StreamImages(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Image], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ImageService_ServiceDesc.Streams[0], ImageService_StreamImages_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, Image]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ImageService_StreamImagesClient = grpc.ServerStreamingClient[Image]
+
+func (c *imageServiceClient) GetImageByID(ctx context.Context, in *ImageId, opts ...grpc.CallOption) (*Image, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Image)
+	err := c.cc.Invoke(ctx, ImageService_GetImageByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) GetImagesByIDs(ctx context.Context, in *ImageIds, opts ...grpc.CallOption) (*ImageList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImageList)
+	err := c.cc.Invoke(ctx, ImageService_GetImagesByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) EnrichStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImageStreamRequest, ImageStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ImageService_ServiceDesc.Streams[1], ImageService_EnrichStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImageStreamRequest, ImageStreamResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ImageService_EnrichStreamClient = grpc.BidiStreamingClient[ImageStreamRequest, ImageStreamResponse]
+
+func (c *imageServiceClient) CreateImage(ctx context.Context, in *Image, opts ...grpc.CallOption) (*Image, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Image)
+	err := c.cc.Invoke(ctx, ImageService_CreateImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) UpdateImage(ctx context.Context, in *Image, opts ...grpc.CallOption) (*Image, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Image)
+	err := c.cc.Invoke(ctx, ImageService_UpdateImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) PatchImage(ctx context.Context, in *PatchImageRequest, opts ...grpc.CallOption) (*Image, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Image)
+	err := c.cc.Invoke(ctx, ImageService_PatchImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) DeleteImage(ctx context.Context, in *ImageId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ImageService_DeleteImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImageServiceServer is the server API for ImageService service.
+// All implementations must embed UnimplementedImageServiceServer
+// for forward compatibility.
+type ImageServiceServer interface {
+	GetAllImages(context.Context, *emptypb.Empty) (*ImageList, error)
+	// StreamImages envia um Image por mensagem, sem montar a lista inteira
+	StreamImages(*emptypb.Empty, grpc.ServerStreamingServer[Image]) error
+	GetImageByID(context.Context, *ImageId) (*Image, error)
+	GetImagesByIDs(context.Context, *ImageIds) (*ImageList, error)
+	// EnrichStream atende pedidos por ID em um único stream de longa duração;
+	// as respostas podem chegar fora da ordem dos pedidos
+	EnrichStream(grpc.BidiStreamingServer[ImageStreamRequest, ImageStreamResponse]) error
+	// As escritas validam o registro como as stacks HTTP. CreateImage ignora o
+	// id recebido e devolve o atribuído; UpdateImage e PatchImage usam o id para
+	// achar o registro
+	CreateImage(context.Context, *Image) (*Image, error)
+	UpdateImage(context.Context, *Image) (*Image, error)
+	PatchImage(context.Context, *PatchImageRequest) (*Image, error)
+	DeleteImage(context.Context, *ImageId) (*emptypb.Empty, error)
+	mustEmbedUnimplementedImageServiceServer()
+}
+
+// UnimplementedImageServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedImageServiceServer struct{}
+
+func (UnimplementedImageServiceServer) GetAllImages(context.Context, *emptypb.Empty) (*ImageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllImages not implemented")
+}
+func (UnimplementedImageServiceServer) StreamImages(*emptypb.Empty, grpc.ServerStreamingServer[Image]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamImages not implemented")
+}
+func (UnimplementedImageServiceServer) GetImageByID(context.Context, *ImageId) (*Image, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImageByID not implemented")
+}
+func (UnimplementedImageServiceServer) GetImagesByIDs(context.Context, *ImageIds) (*ImageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImagesByIDs not implemented")
+}
+func (UnimplementedImageServiceServer) EnrichStream(grpc.BidiStreamingServer[ImageStreamRequest, ImageStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method EnrichStream not implemented")
+}
+func (UnimplementedImageServiceServer) CreateImage(context.Context, *Image) (*Image, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateImage not implemented")
+}
+func (UnimplementedImageServiceServer) UpdateImage(context.Context, *Image) (*Image, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateImage not implemented")
+}
+func (UnimplementedImageServiceServer) PatchImage(context.Context, *PatchImageRequest) (*Image, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchImage not implemented")
+}
+func (UnimplementedImageServiceServer) DeleteImage(context.Context, *ImageId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteImage not implemented")
+}
+func (UnimplementedImageServiceServer) mustEmbedUnimplementedImageServiceServer() {}
+func (UnimplementedImageServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeImageServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ImageServiceServer will
+// result in compilation errors.
+type UnsafeImageServiceServer interface {
+	mustEmbedUnimplementedImageServiceServer()
+}
+
+func RegisterImageServiceServer(s grpc.ServiceRegistrar, srv ImageServiceServer) {
+	// If the following call pancis, it indicates UnimplementedImageServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ImageService_ServiceDesc, srv)
+}
+
+func _ImageService_GetAllImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).GetAllImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_GetAllImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).GetAllImages(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_StreamImages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ImageServiceServer).StreamImages(m, &grpc.GenericServerStream[emptypb.Empty, Image]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ImageService_StreamImagesServer = grpc.ServerStreamingServer[Image]
+
+func _ImageService_GetImageByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).GetImageByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_GetImageByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).GetImageByID(ctx, req.(*ImageId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_GetImagesByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).GetImagesByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_GetImagesByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).GetImagesByIDs(ctx, req.(*ImageIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_EnrichStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ImageServiceServer).EnrichStream(&grpc.GenericServerStream[ImageStreamRequest, ImageStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ImageService_EnrichStreamServer = grpc.BidiStreamingServer[ImageStreamRequest, ImageStreamResponse]
+
+func _ImageService_CreateImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Image)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).CreateImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_CreateImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).CreateImage(ctx, req.(*Image))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_UpdateImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Image)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).UpdateImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_UpdateImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).UpdateImage(ctx, req.(*Image))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_PatchImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).PatchImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_PatchImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).PatchImage(ctx, req.(*PatchImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_DeleteImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).DeleteImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_DeleteImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).DeleteImage(ctx, req.(*ImageId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ImageService_ServiceDesc is the grpc.ServiceDesc for ImageService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ImageService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.ImageService",
+	HandlerType: (*ImageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAllImages",
+			Handler:    _ImageService_GetAllImages_Handler,
+		},
+		{
+			MethodName: "GetImageByID",
+			Handler:    _ImageService_GetImageByID_Handler,
+		},
+		{
+			MethodName: "GetImagesByIDs",
+			Handler:    _ImageService_GetImagesByIDs_Handler,
+		},
+		{
+			MethodName: "CreateImage",
+			Handler:    _ImageService_CreateImage_Handler,
+		},
+		{
+			MethodName: "UpdateImage",
+			Handler:    _ImageService_UpdateImage_Handler,
+		},
+		{
+			MethodName: "PatchImage",
+			Handler:    _ImageService_PatchImage_Handler,
+		},
+		{
+			MethodName: "DeleteImage",
+			Handler:    _ImageService_DeleteImage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamImages",
+			Handler:       _ImageService_StreamImages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "EnrichStream",
+			Handler:       _ImageService_EnrichStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/image/image.proto",
+}
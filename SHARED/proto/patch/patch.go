@@ -0,0 +1,61 @@
+// Package patch aplica o PATCH dos contextos gRPC: os campos de um
+// google.protobuf.FieldMask, ou os preenchidos quando a máscara vem vazia.
+package patch
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Apply copia de src para dst os campos de paths, que podem descer em
+// mensagens como price.original. Sem paths, copia os campos preenchidos de
+// src; campos com valor zero não são enviados no proto3, então zerar um campo
+// pede a máscara. O id identifica o registro e nunca é copiado.
+func Apply(dst, src proto.Message, paths []string) error {
+	d, s := dst.ProtoReflect(), src.ProtoReflect()
+	if d.Descriptor() != s.Descriptor() {
+		return fmt.Errorf("%s aplicado sobre %s", s.Descriptor().FullName(), d.Descriptor().FullName())
+	}
+
+	if len(paths) == 0 {
+		s.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			if fd.Name() != "id" {
+				d.Set(fd, v)
+			}
+			return true
+		})
+		return nil
+	}
+
+	for _, path := range paths {
+		if path == "id" {
+			return fmt.Errorf("update_mask: o id não pode ser alterado")
+		}
+		if err := apply(d, s, strings.Split(path, ".")); err != nil {
+			return fmt.Errorf("update_mask: %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+func apply(dst, src protoreflect.Message, names []string) error {
+	fd := dst.Descriptor().Fields().ByName(protoreflect.Name(names[0]))
+	if fd == nil {
+		return fmt.Errorf("campo desconhecido")
+	}
+	if len(names) > 1 {
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return fmt.Errorf("%s não é uma mensagem", fd.Name())
+		}
+		return apply(dst.Mutable(fd).Message(), src.Get(fd).Message(), names[1:])
+	}
+	if src.Has(fd) {
+		dst.Set(fd, src.Get(fd))
+	} else {
+		dst.Clear(fd)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package patch
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	brandpb "shared/proto/brand"
+	productpb "shared/proto/product"
+)
+
+func TestApply(t *testing.T) {
+	current := &productpb.Product{Id: 1, Name: "Produto", Slug: "produto", Description: "Descrição", Price: &productpb.Price{Original: 10, SpecialPrice: 5}, SellerId: 2, Categories: []int32{1, 2}}
+	src := &productpb.Product{Id: 9, Name: "Alterado", Price: &productpb.Price{Original: 20}, Categories: []int32{7}}
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  *productpb.Product
+	}{
+		{"sem máscara", nil, &productpb.Product{Id: 1, Name: "Alterado", Slug: "produto", Description: "Descrição", Price: &productpb.Price{Original: 20}, SellerId: 2, Categories: []int32{7}}},
+		{"campos da máscara", []string{"name", "description"}, &productpb.Product{Id: 1, Name: "Alterado", Slug: "produto", Price: &productpb.Price{Original: 10, SpecialPrice: 5}, SellerId: 2, Categories: []int32{1, 2}}},
+		{"campo aninhado", []string{"price.special_price"}, &productpb.Product{Id: 1, Name: "Produto", Slug: "produto", Description: "Descrição", Price: &productpb.Price{Original: 10}, SellerId: 2, Categories: []int32{1, 2}}},
+	}
+	for _, tt := range tests {
+		dst := proto.Clone(current).(*productpb.Product)
+		if err := Apply(dst, src, tt.paths); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if !proto.Equal(dst, tt.want) {
+			t.Errorf("%s: %v, esperado %v", tt.name, dst, tt.want)
+		}
+	}
+	if current.Name != "Produto" || len(current.Categories) != 2 {
+		t.Errorf("Apply alterou a mensagem original: %v", current)
+	}
+}
+
+func TestApplyInvalid(t *testing.T) {
+	for _, paths := range [][]string{{"id"}, {"nome"}, {"name.first"}, {"price.desconto"}} {
+		dst := &productpb.Product{Id: 1}
+		if err := Apply(dst, &productpb.Product{}, paths); err == nil {
+			t.Errorf("%v aceito", paths)
+		}
+	}
+	if err := Apply(&productpb.Product{}, &brandpb.Brand{}, nil); err == nil {
+		t.Error("mensagens de tipos diferentes aceitas")
+	}
+}
@@ -0,0 +1,156 @@
+package productpb
+
+import (
+	"shared/domain"
+	brandpb "shared/proto/brand"
+	categorypb "shared/proto/category"
+	imagepb "shared/proto/image"
+	sellerpb "shared/proto/seller"
+)
+
+// ToDomain converte a mensagem recebida de products-api para o produto usado
+// na agregação do BFF
+func (p *Product) ToDomain() *domain.Product {
+	product := &domain.Product{
+		ID:          int(p.Id),
+		Name:        p.Name,
+		Slug:        p.Slug,
+		Description: p.Description,
+		SellerID:    int(p.SellerId),
+		BrandID:     int(p.BrandId),
+		Categories:  make([]int, len(p.Categories)),
+		Images:      make([]int, len(p.Images)),
+	}
+	if p.Price != nil {
+		product.Price = domain.Price{
+			Original:     float64(p.Price.Original),
+			SpecialPrice: float64(p.Price.SpecialPrice),
+		}
+	}
+	for i, id := range p.Categories {
+		product.Categories[i] = int(id)
+	}
+	for i, id := range p.Images {
+		product.Images[i] = int(id)
+	}
+	return product
+}
+
+// ToDomain converte o produto montado pela products-api, devolvendo as
+// categorias e imagens que falharam lá, que não vêm na mensagem, como nil na
+// posição indicada em errors
+func (p *EnrichedProduct) ToDomain() *domain.EnrichedProduct {
+	enriched := &domain.EnrichedProduct{
+		ID:          int(p.Id),
+		Name:        p.Name,
+		Slug:        p.Slug,
+		Description: p.Description,
+	}
+	if p.Price != nil {
+		enriched.Price = domain.Price{
+			Original:     float64(p.Price.Original),
+			SpecialPrice: float64(p.Price.SpecialPrice),
+		}
+	}
+	if p.Seller != nil {
+		enriched.Seller = p.Seller.ToDomain()
+	}
+	if p.Brand != nil {
+		enriched.Brand = p.Brand.ToDomain()
+	}
+
+	failed := map[string]map[int]bool{"categories": {}, "images": {}}
+	for _, e := range p.Errors {
+		enriched.Errors = append(enriched.Errors, domain.EnrichError{Field: e.Field, ID: int(e.Id), Index: int(e.Index), Error: e.Error})
+		if slots, ok := failed[e.Field]; ok {
+			slots[int(e.Index)] = true
+		}
+	}
+	enriched.Categories = withGaps(p.Categories, failed["categories"], (*categorypb.Category).ToDomain)
+	enriched.Images = withGaps(p.Images, failed["images"], (*imagepb.Image).ToDomain)
+	return enriched
+}
+
+// withGaps converte items deixando nil nas posições de failed
+func withGaps[M, T any](items []M, failed map[int]bool, convert func(M) *T) []*T {
+	result := make([]*T, 0, len(items)+len(failed))
+	for _, item := range items {
+		for failed[len(result)] {
+			result = append(result, nil)
+		}
+		result = append(result, convert(item))
+	}
+	for failed[len(result)] {
+		result = append(result, nil)
+	}
+	return result
+}
+
+func FromDomain(p domain.Product) *Product {
+	return &Product{
+		Id:          int32(p.ID),
+		Name:        p.Name,
+		Slug:        p.Slug,
+		Description: p.Description,
+		Price:       priceFromDomain(p.Price),
+		SellerId:    int32(p.SellerID),
+		BrandId:     int32(p.BrandID),
+		Categories:  toInt32(p.Categories),
+		Images:      toInt32(p.Images),
+	}
+}
+
+func ListFromDomain(products []domain.Product) *ProductList {
+	list := &ProductList{}
+	for _, p := range products {
+		list.Products = append(list.Products, FromDomain(p))
+	}
+	return list
+}
+
+// EnrichedFromDomain deixa de fora as categorias e imagens nil, que o
+// protobuf não representa em campos repeated; a posição delas segue em errors
+func EnrichedFromDomain(p domain.EnrichedProduct) *EnrichedProduct {
+	m := &EnrichedProduct{
+		Id:          int32(p.ID),
+		Name:        p.Name,
+		Slug:        p.Slug,
+		Description: p.Description,
+		Price:       priceFromDomain(p.Price),
+	}
+	if p.Seller != nil {
+		m.Seller = sellerpb.FromDomain(*p.Seller)
+	}
+	if p.Brand != nil {
+		m.Brand = brandpb.FromDomain(*p.Brand)
+	}
+	for _, c := range p.Categories {
+		if c != nil {
+			m.Categories = append(m.Categories, categorypb.FromDomain(*c))
+		}
+	}
+	for _, i := range p.Images {
+		if i != nil {
+			m.Images = append(m.Images, imagepb.FromDomain(*i))
+		}
+	}
+	for _, e := range p.Errors {
+		m.Errors = append(m.Errors, &EnrichError{Field: e.Field, Id: int32(e.ID), Index: int32(e.Index), Error: e.Error})
+	}
+	return m
+}
+
+func priceFromDomain(p domain.Price) *Price {
+	return &Price{
+		Original:     float32(p.Original),
+		SpecialPrice: float32(p.SpecialPrice),
+	}
+}
+
+func toInt32(ids []int) []int32 {
+	result := make([]int32, len(ids))
+	for i, id := range ids {
+		result[i] = int32(id)
+	}
+	return result
+}
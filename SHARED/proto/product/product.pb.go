@@ -0,0 +1,707 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: proto/product/product.proto
+
+package productpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	brand "shared/proto/brand"
+	category "shared/proto/category"
+	image "shared/proto/image"
+	seller "shared/proto/seller"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Product struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         *Price                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	SellerId      int32                  `protobuf:"varint,6,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	BrandId       int32                  `protobuf:"varint,7,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	Categories    []int32                `protobuf:"varint,8,rep,packed,name=categories,proto3" json:"categories,omitempty"`
+	Images        []int32                `protobuf:"varint,9,rep,packed,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_proto_product_product_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Product) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Product) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Product) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Product) GetSellerId() int32 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *Product) GetBrandId() int32 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *Product) GetCategories() []int32 {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *Product) GetImages() []int32 {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type Slug struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Slug) Reset() {
+	*x = Slug{}
+	mi := &file_proto_product_product_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Slug) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Slug) ProtoMessage() {}
+
+func (x *Slug) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Slug.ProtoReflect.Descriptor instead.
+func (*Slug) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Slug) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type ProductList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductList) Reset() {
+	*x = ProductList{}
+	mi := &file_proto_product_product_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductList) ProtoMessage() {}
+
+func (x *ProductList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductList.ProtoReflect.Descriptor instead.
+func (*ProductList) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProductList) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type Price struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Original      float32                `protobuf:"fixed32,1,opt,name=original,proto3" json:"original,omitempty"`
+	SpecialPrice  float32                `protobuf:"fixed32,2,opt,name=special_price,json=specialPrice,proto3" json:"special_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	mi := &file_proto_product_product_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Price) GetOriginal() float32 {
+	if x != nil {
+		return x.Original
+	}
+	return 0
+}
+
+func (x *Price) GetSpecialPrice() float32 {
+	if x != nil {
+		return x.SpecialPrice
+	}
+	return 0
+}
+
+// EnrichedProduct é o produto montado pela própria products-api, com as
+// entidades dos outros contextos. As que falharam ficam de fora e vêm em
+// errors, com a posição que teriam em categories ou images.
+type EnrichedProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         *Price                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	Seller        *seller.Seller         `protobuf:"bytes,6,opt,name=seller,proto3" json:"seller,omitempty"`
+	Brand         *brand.Brand           `protobuf:"bytes,7,opt,name=brand,proto3" json:"brand,omitempty"`
+	Categories    []*category.Category   `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty"`
+	Images        []*image.Image         `protobuf:"bytes,9,rep,name=images,proto3" json:"images,omitempty"`
+	Errors        []*EnrichError         `protobuf:"bytes,10,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrichedProduct) Reset() {
+	*x = EnrichedProduct{}
+	mi := &file_proto_product_product_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrichedProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrichedProduct) ProtoMessage() {}
+
+func (x *EnrichedProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrichedProduct.ProtoReflect.Descriptor instead.
+func (*EnrichedProduct) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EnrichedProduct) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EnrichedProduct) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnrichedProduct) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *EnrichedProduct) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EnrichedProduct) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *EnrichedProduct) GetSeller() *seller.Seller {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *EnrichedProduct) GetBrand() *brand.Brand {
+	if x != nil {
+		return x.Brand
+	}
+	return nil
+}
+
+func (x *EnrichedProduct) GetCategories() []*category.Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *EnrichedProduct) GetImages() []*image.Image {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *EnrichedProduct) GetErrors() []*EnrichError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type EnrichError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Index         int32                  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrichError) Reset() {
+	*x = EnrichError{}
+	mi := &file_proto_product_product_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrichError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrichError) ProtoMessage() {}
+
+func (x *EnrichError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrichError.ProtoReflect.Descriptor instead.
+func (*EnrichError) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EnrichError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *EnrichError) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EnrichError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *EnrichError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ProductId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductId) Reset() {
+	*x = ProductId{}
+	mi := &file_proto_product_product_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductId) ProtoMessage() {}
+
+func (x *ProductId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductId.ProtoReflect.Descriptor instead.
+func (*ProductId) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProductId) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// PatchProductRequest altera só os campos de update_mask, com os valores de
+// product; sem máscara, altera os campos preenchidos
+type PatchProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchProductRequest) Reset() {
+	*x = PatchProductRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchProductRequest) ProtoMessage() {}
+
+func (x *PatchProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchProductRequest.ProtoReflect.Descriptor instead.
+func (*PatchProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PatchProductRequest) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *PatchProductRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+var File_proto_product_product_proto protoreflect.FileDescriptor
+
+const file_proto_product_product_proto_rawDesc = "" +
+	"\n" +
+	"\x1bproto/product/product.proto\x12\x05proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x17proto/brand/brand.proto\x1a\x1dproto/category/category.proto\x1a\x17proto/image/image.proto\x1a\x19proto/seller/seller.proto\"\xf7\x01\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\"\n" +
+	"\x05price\x18\x05 \x01(\v2\f.proto.PriceR\x05price\x12\x1b\n" +
+	"\tseller_id\x18\x06 \x01(\x05R\bsellerId\x12\x19\n" +
+	"\bbrand_id\x18\a \x01(\x05R\abrandId\x12\x1e\n" +
+	"\n" +
+	"categories\x18\b \x03(\x05R\n" +
+	"categories\x12\x16\n" +
+	"\x06images\x18\t \x03(\x05R\x06images\"\x1a\n" +
+	"\x04Slug\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"9\n" +
+	"\vProductList\x12*\n" +
+	"\bproducts\x18\x01 \x03(\v2\x0e.proto.ProductR\bproducts\"H\n" +
+	"\x05Price\x12\x1a\n" +
+	"\boriginal\x18\x01 \x01(\x02R\boriginal\x12#\n" +
+	"\rspecial_price\x18\x02 \x01(\x02R\fspecialPrice\"\xdd\x02\n" +
+	"\x0fEnrichedProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\"\n" +
+	"\x05price\x18\x05 \x01(\v2\f.proto.PriceR\x05price\x12%\n" +
+	"\x06seller\x18\x06 \x01(\v2\r.proto.SellerR\x06seller\x12\"\n" +
+	"\x05brand\x18\a \x01(\v2\f.proto.BrandR\x05brand\x12/\n" +
+	"\n" +
+	"categories\x18\b \x03(\v2\x0f.proto.CategoryR\n" +
+	"categories\x12$\n" +
+	"\x06images\x18\t \x03(\v2\f.proto.ImageR\x06images\x12*\n" +
+	"\x06errors\x18\n" +
+	" \x03(\v2\x12.proto.EnrichErrorR\x06errors\"_\n" +
+	"\vEnrichError\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05index\x18\x03 \x01(\x05R\x05index\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x1b\n" +
+	"\tProductId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"|\n" +
+	"\x13PatchProductRequest\x12(\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0e.proto.ProductR\aproduct\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask2\xcf\x03\n" +
+	"\x0eProductService\x12<\n" +
+	"\x0eGetAllProducts\x12\x16.google.protobuf.Empty\x1a\x12.proto.ProductList\x12:\n" +
+	"\x0eStreamProducts\x12\x16.google.protobuf.Empty\x1a\x0e.proto.Product0\x01\x12/\n" +
+	"\x10GetProductBySlug\x12\v.proto.Slug\x1a\x0e.proto.Product\x129\n" +
+	"\x12GetEnrichedProduct\x12\v.proto.Slug\x1a\x16.proto.EnrichedProduct\x12/\n" +
+	"\rCreateProduct\x12\x0e.proto.Product\x1a\x0e.proto.Product\x12/\n" +
+	"\rUpdateProduct\x12\x0e.proto.Product\x1a\x0e.proto.Product\x12:\n" +
+	"\fPatchProduct\x12\x1a.proto.PatchProductRequest\x1a\x0e.proto.Product\x129\n" +
+	"\rDeleteProduct\x12\x10.proto.ProductId\x1a\x16.google.protobuf.EmptyB\x1bZ\x19./proto/product;productpbb\x06proto3"
+
+var (
+	file_proto_product_product_proto_rawDescOnce sync.Once
+	file_proto_product_product_proto_rawDescData []byte
+)
+
+func file_proto_product_product_proto_rawDescGZIP() []byte {
+	file_proto_product_product_proto_rawDescOnce.Do(func() {
+		file_proto_product_product_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_product_product_proto_rawDesc), len(file_proto_product_product_proto_rawDesc)))
+	})
+	return file_proto_product_product_proto_rawDescData
+}
+
+var file_proto_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_product_product_proto_goTypes = []any{
+	(*Product)(nil),               // 0: proto.Product
+	(*Slug)(nil),                  // 1: proto.Slug
+	(*ProductList)(nil),           // 2: proto.ProductList
+	(*Price)(nil),                 // 3: proto.Price
+	(*EnrichedProduct)(nil),       // 4: proto.EnrichedProduct
+	(*EnrichError)(nil),           // 5: proto.EnrichError
+	(*ProductId)(nil),             // 6: proto.ProductId
+	(*PatchProductRequest)(nil),   // 7: proto.PatchProductRequest
+	(*seller.Seller)(nil),         // 8: proto.Seller
+	(*brand.Brand)(nil),           // 9: proto.Brand
+	(*category.Category)(nil),     // 10: proto.Category
+	(*image.Image)(nil),           // 11: proto.Image
+	(*fieldmaskpb.FieldMask)(nil), // 12: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 13: google.protobuf.Empty
+}
+var file_proto_product_product_proto_depIdxs = []int32{
+	3,  // 0: proto.Product.price:type_name -> proto.Price
+	0,  // 1: proto.ProductList.products:type_name -> proto.Product
+	3,  // 2: proto.EnrichedProduct.price:type_name -> proto.Price
+	8,  // 3: proto.EnrichedProduct.seller:type_name -> proto.Seller
+	9,  // 4: proto.EnrichedProduct.brand:type_name -> proto.Brand
+	10, // 5: proto.EnrichedProduct.categories:type_name -> proto.Category
+	11, // 6: proto.EnrichedProduct.images:type_name -> proto.Image
+	5,  // 7: proto.EnrichedProduct.errors:type_name -> proto.EnrichError
+	0,  // 8: proto.PatchProductRequest.product:type_name -> proto.Product
+	12, // 9: proto.PatchProductRequest.update_mask:type_name -> google.protobuf.FieldMask
+	13, // 10: proto.ProductService.GetAllProducts:input_type -> google.protobuf.Empty
+	13, // 11: proto.ProductService.StreamProducts:input_type -> google.protobuf.Empty
+	1,  // 12: proto.ProductService.GetProductBySlug:input_type -> proto.Slug
+	1,  // 13: proto.ProductService.GetEnrichedProduct:input_type -> proto.Slug
+	0,  // 14: proto.ProductService.CreateProduct:input_type -> proto.Product
+	0,  // 15: proto.ProductService.UpdateProduct:input_type -> proto.Product
+	7,  // 16: proto.ProductService.PatchProduct:input_type -> proto.PatchProductRequest
+	6,  // 17: proto.ProductService.DeleteProduct:input_type -> proto.ProductId
+	2,  // 18: proto.ProductService.GetAllProducts:output_type -> proto.ProductList
+	0,  // 19: proto.ProductService.StreamProducts:output_type -> proto.Product
+	0,  // 20: proto.ProductService.GetProductBySlug:output_type -> proto.Product
+	4,  // 21: proto.ProductService.GetEnrichedProduct:output_type -> proto.EnrichedProduct
+	0,  // 22: proto.ProductService.CreateProduct:output_type -> proto.Product
+	0,  // 23: proto.ProductService.UpdateProduct:output_type -> proto.Product
+	0,  // 24: proto.ProductService.PatchProduct:output_type -> proto.Product
+	13, // 25: proto.ProductService.DeleteProduct:output_type -> google.protobuf.Empty
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_proto_product_product_proto_init() }
+func file_proto_product_product_proto_init() {
+	if File_proto_product_product_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_product_product_proto_rawDesc), len(file_proto_product_product_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_product_product_proto_goTypes,
+		DependencyIndexes: file_proto_product_product_proto_depIdxs,
+		MessageInfos:      file_proto_product_product_proto_msgTypes,
+	}.Build()
+	File_proto_product_product_proto = out.File
+	file_proto_product_product_proto_goTypes = nil
+	file_proto_product_product_proto_depIdxs = nil
+}
@@ -0,0 +1,19 @@
+package sellerpb
+
+import "shared/domain"
+
+func (s *Seller) ToDomain() *domain.Seller {
+	return &domain.Seller{ID: int(s.Id), Name: s.Name}
+}
+
+func FromDomain(s domain.Seller) *Seller {
+	return &Seller{Id: int32(s.ID), Name: s.Name}
+}
+
+func ListFromDomain(sellers []domain.Seller) *SellerList {
+	list := &SellerList{}
+	for _, s := range sellers {
+		list.Sellers = append(list.Sellers, FromDomain(s))
+	}
+	return list
+}
@@ -0,0 +1,452 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: proto/seller/seller.proto
+
+package sellerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Seller struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Seller) Reset() {
+	*x = Seller{}
+	mi := &file_proto_seller_seller_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Seller) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Seller) ProtoMessage() {}
+
+func (x *Seller) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_seller_seller_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Seller.ProtoReflect.Descriptor instead.
+func (*Seller) Descriptor() ([]byte, []int) {
+	return file_proto_seller_seller_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Seller) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Seller) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SellerId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SellerId) Reset() {
+	*x = SellerId{}
+	mi := &file_proto_seller_seller_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SellerId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellerId) ProtoMessage() {}
+
+func (x *SellerId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_seller_seller_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellerId.ProtoReflect.Descriptor instead.
+func (*SellerId) Descriptor() ([]byte, []int) {
+	return file_proto_seller_seller_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SellerId) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SellerList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sellers       []*Seller              `protobuf:"bytes,1,rep,name=sellers,proto3" json:"sellers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SellerList) Reset() {
+	*x = SellerList{}
+	mi := &file_proto_seller_seller_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SellerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellerList) ProtoMessage() {}
+
+func (x *SellerList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_seller_seller_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellerList.ProtoReflect.Descriptor instead.
+func (*SellerList) Descriptor() ([]byte, []int) {
+	return file_proto_seller_seller_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SellerList) GetSellers() []*Seller {
+	if x != nil {
+		return x.Sellers
+	}
+	return nil
+}
+
+// SellerStreamRequest e SellerStreamResponse trafegam no EnrichStream; a tag
+// liga cada resposta ao pedido que a originou
+type SellerStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           uint64                 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SellerStreamRequest) Reset() {
+	*x = SellerStreamRequest{}
+	mi := &file_proto_seller_seller_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SellerStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellerStreamRequest) ProtoMessage() {}
+
+func (x *SellerStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_seller_seller_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellerStreamRequest.ProtoReflect.Descriptor instead.
+func (*SellerStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_seller_seller_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SellerStreamRequest) GetTag() uint64 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *SellerStreamRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SellerStreamResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Tag    uint64                 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Seller *Seller                `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// error e code vêm preenchidos quando a busca falha; code é o código
+	// gRPC que a chamada unária devolveria
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Code          int32  `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SellerStreamResponse) Reset() {
+	*x = SellerStreamResponse{}
+	mi := &file_proto_seller_seller_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SellerStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellerStreamResponse) ProtoMessage() {}
+
+func (x *SellerStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_seller_seller_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellerStreamResponse.ProtoReflect.Descriptor instead.
+func (*SellerStreamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_seller_seller_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SellerStreamResponse) GetTag() uint64 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *SellerStreamResponse) GetSeller() *Seller {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *SellerStreamResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SellerStreamResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+// PatchSellerRequest altera só os campos de update_mask, com os valores de
+// seller; sem máscara, altera os campos preenchidos
+type PatchSellerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seller        *Seller                `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchSellerRequest) Reset() {
+	*x = PatchSellerRequest{}
+	mi := &file_proto_seller_seller_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchSellerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchSellerRequest) ProtoMessage() {}
+
+func (x *PatchSellerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_seller_seller_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchSellerRequest.ProtoReflect.Descriptor instead.
+func (*PatchSellerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_seller_seller_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PatchSellerRequest) GetSeller() *Seller {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *PatchSellerRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+var File_proto_seller_seller_proto protoreflect.FileDescriptor
+
+const file_proto_seller_seller_proto_rawDesc = "" +
+	"\n" +
+	"\x19proto/seller/seller.proto\x12\x05proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\",\n" +
+	"\x06Seller\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x1a\n" +
+	"\bSellerId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"5\n" +
+	"\n" +
+	"SellerList\x12'\n" +
+	"\asellers\x18\x01 \x03(\v2\r.proto.SellerR\asellers\"7\n" +
+	"\x13SellerStreamRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\x04R\x03tag\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"y\n" +
+	"\x14SellerStreamResponse\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\x04R\x03tag\x12%\n" +
+	"\x06seller\x18\x02 \x01(\v2\r.proto.SellerR\x06seller\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\x05R\x04code\"x\n" +
+	"\x12PatchSellerRequest\x12%\n" +
+	"\x06seller\x18\x01 \x01(\v2\r.proto.SellerR\x06seller\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask2\xd1\x03\n" +
+	"\rSellerService\x12:\n" +
+	"\rGetAllSellers\x12\x16.google.protobuf.Empty\x1a\x11.proto.SellerList\x128\n" +
+	"\rStreamSellers\x12\x16.google.protobuf.Empty\x1a\r.proto.Seller0\x01\x12/\n" +
+	"\rGetSellerByID\x12\x0f.proto.SellerId\x1a\r.proto.Seller\x12K\n" +
+	"\fEnrichStream\x12\x1a.proto.SellerStreamRequest\x1a\x1b.proto.SellerStreamResponse(\x010\x01\x12,\n" +
+	"\fCreateSeller\x12\r.proto.Seller\x1a\r.proto.Seller\x12,\n" +
+	"\fUpdateSeller\x12\r.proto.Seller\x1a\r.proto.Seller\x127\n" +
+	"\vPatchSeller\x12\x19.proto.PatchSellerRequest\x1a\r.proto.Seller\x127\n" +
+	"\fDeleteSeller\x12\x0f.proto.SellerId\x1a\x16.google.protobuf.EmptyB\x19Z\x17./proto/seller;sellerpbb\x06proto3"
+
+var (
+	file_proto_seller_seller_proto_rawDescOnce sync.Once
+	file_proto_seller_seller_proto_rawDescData []byte
+)
+
+func file_proto_seller_seller_proto_rawDescGZIP() []byte {
+	file_proto_seller_seller_proto_rawDescOnce.Do(func() {
+		file_proto_seller_seller_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_seller_seller_proto_rawDesc), len(file_proto_seller_seller_proto_rawDesc)))
+	})
+	return file_proto_seller_seller_proto_rawDescData
+}
+
+var file_proto_seller_seller_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_seller_seller_proto_goTypes = []any{
+	(*Seller)(nil),                // 0: proto.Seller
+	(*SellerId)(nil),              // 1: proto.SellerId
+	(*SellerList)(nil),            // 2: proto.SellerList
+	(*SellerStreamRequest)(nil),   // 3: proto.SellerStreamRequest
+	(*SellerStreamResponse)(nil),  // 4: proto.SellerStreamResponse
+	(*PatchSellerRequest)(nil),    // 5: proto.PatchSellerRequest
+	(*fieldmaskpb.FieldMask)(nil), // 6: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 7: google.protobuf.Empty
+}
+var file_proto_seller_seller_proto_depIdxs = []int32{
+	0,  // 0: proto.SellerList.sellers:type_name -> proto.Seller
+	0,  // 1: proto.SellerStreamResponse.seller:type_name -> proto.Seller
+	0,  // 2: proto.PatchSellerRequest.seller:type_name -> proto.Seller
+	6,  // 3: proto.PatchSellerRequest.update_mask:type_name -> google.protobuf.FieldMask
+	7,  // 4: proto.SellerService.GetAllSellers:input_type -> google.protobuf.Empty
+	7,  // 5: proto.SellerService.StreamSellers:input_type -> google.protobuf.Empty
+	1,  // 6: proto.SellerService.GetSellerByID:input_type -> proto.SellerId
+	3,  // 7: proto.SellerService.EnrichStream:input_type -> proto.SellerStreamRequest
+	0,  // 8: proto.SellerService.CreateSeller:input_type -> proto.Seller
+	0,  // 9: proto.SellerService.UpdateSeller:input_type -> proto.Seller
+	5,  // 10: proto.SellerService.PatchSeller:input_type -> proto.PatchSellerRequest
+	1,  // 11: proto.SellerService.DeleteSeller:input_type -> proto.SellerId
+	2,  // 12: proto.SellerService.GetAllSellers:output_type -> proto.SellerList
+	0,  // 13: proto.SellerService.StreamSellers:output_type -> proto.Seller
+	0,  // 14: proto.SellerService.GetSellerByID:output_type -> proto.Seller
+	4,  // 15: proto.SellerService.EnrichStream:output_type -> proto.SellerStreamResponse
+	0,  // 16: proto.SellerService.CreateSeller:output_type -> proto.Seller
+	0,  // 17: proto.SellerService.UpdateSeller:output_type -> proto.Seller
+	0,  // 18: proto.SellerService.PatchSeller:output_type -> proto.Seller
+	7,  // 19: proto.SellerService.DeleteSeller:output_type -> google.protobuf.Empty
+	12, // [12:20] is the sub-list for method output_type
+	4,  // [4:12] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_seller_seller_proto_init() }
+func file_proto_seller_seller_proto_init() {
+	if File_proto_seller_seller_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_seller_seller_proto_rawDesc), len(file_proto_seller_seller_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_seller_seller_proto_goTypes,
+		DependencyIndexes: file_proto_seller_seller_proto_depIdxs,
+		MessageInfos:      file_proto_seller_seller_proto_msgTypes,
+	}.Build()
+	File_proto_seller_seller_proto = out.File
+	file_proto_seller_seller_proto_goTypes = nil
+	file_proto_seller_seller_proto_depIdxs = nil
+}
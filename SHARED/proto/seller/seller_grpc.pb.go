@@ -0,0 +1,398 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/seller/seller.proto
+
+package sellerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SellerService_GetAllSellers_FullMethodName = "/proto.SellerService/GetAllSellers"
+	SellerService_StreamSellers_FullMethodName = "/proto.SellerService/StreamSellers"
+	SellerService_GetSellerByID_FullMethodName = "/proto.SellerService/GetSellerByID"
+	SellerService_EnrichStream_FullMethodName  = "/proto.SellerService/EnrichStream"
+	SellerService_CreateSeller_FullMethodName  = "/proto.SellerService/CreateSeller"
+	SellerService_UpdateSeller_FullMethodName  = "/proto.SellerService/UpdateSeller"
+	SellerService_PatchSeller_FullMethodName   = "/proto.SellerService/PatchSeller"
+	SellerService_DeleteSeller_FullMethodName  = "/proto.SellerService/DeleteSeller"
+)
+
+// SellerServiceClient is the client API for SellerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SellerServiceClient interface {
+	GetAllSellers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SellerList, error)
+	// StreamSellers envia um Seller por mensagem, sem montar a lista inteira
+	StreamSellers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Seller], error)
+	GetSellerByID(ctx context.Context, in *SellerId, opts ...grpc.CallOption) (*Seller, error)
+	// EnrichStream atende pedidos por ID em um único stream de longa duração;
+	// as respostas podem chegar fora da ordem dos pedidos
+	EnrichStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SellerStreamRequest, SellerStreamResponse], error)
+	// As escritas validam o registro como as stacks HTTP. CreateSeller ignora o
+	// id recebido e devolve o atribuído; UpdateSeller e PatchSeller usam o id
+	// para achar o registro
+	CreateSeller(ctx context.Context, in *Seller, opts ...grpc.CallOption) (*Seller, error)
+	UpdateSeller(ctx context.Context, in *Seller, opts ...grpc.CallOption) (*Seller, error)
+	PatchSeller(ctx context.Context, in *PatchSellerRequest, opts ...grpc.CallOption) (*Seller, error)
+	DeleteSeller(ctx context.Context, in *SellerId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type sellerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSellerServiceClient(cc grpc.ClientConnInterface) SellerServiceClient {
+	return &sellerServiceClient{cc}
+}
+
+func (c *sellerServiceClient) GetAllSellers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SellerList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SellerList)
+	err := c.cc.Invoke(ctx, SellerService_GetAllSellers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sellerServiceClient) StreamSellers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Seller], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SellerService_ServiceDesc.Streams[0], SellerService_StreamSellers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, Seller]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SellerService_StreamSellersClient = grpc.ServerStreamingClient[Seller]
+
+func (c *sellerServiceClient) GetSellerByID(ctx context.Context, in *SellerId, opts ...grpc.CallOption) (*Seller, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Seller)
+	err := c.cc.Invoke(ctx, SellerService_GetSellerByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sellerServiceClient) EnrichStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SellerStreamRequest, SellerStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SellerService_ServiceDesc.Streams[1], SellerService_EnrichStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SellerStreamRequest, SellerStreamResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SellerService_EnrichStreamClient = grpc.BidiStreamingClient[SellerStreamRequest, SellerStreamResponse]
+
+func (c *sellerServiceClient) CreateSeller(ctx context.Context, in *Seller, opts ...grpc.CallOption) (*Seller, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Seller)
+	err := c.cc.Invoke(ctx, SellerService_CreateSeller_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sellerServiceClient) UpdateSeller(ctx context.Context, in *Seller, opts ...grpc.CallOption) (*Seller, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Seller)
+	err := c.cc.Invoke(ctx, SellerService_UpdateSeller_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sellerServiceClient) PatchSeller(ctx context.Context, in *PatchSellerRequest, opts ...grpc.CallOption) (*Seller, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Seller)
+	err := c.cc.Invoke(ctx, SellerService_PatchSeller_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sellerServiceClient) DeleteSeller(ctx context.Context, in *SellerId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SellerService_DeleteSeller_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SellerServiceServer is the server API for SellerService service.
+// All implementations must embed UnimplementedSellerServiceServer
+// for forward compatibility.
+type SellerServiceServer interface {
+	GetAllSellers(context.Context, *emptypb.Empty) (*SellerList, error)
+	// StreamSellers envia um Seller por mensagem, sem montar a lista inteira
+	StreamSellers(*emptypb.Empty, grpc.ServerStreamingServer[Seller]) error
+	GetSellerByID(context.Context, *SellerId) (*Seller, error)
+	// EnrichStream atende pedidos por ID em um único stream de longa duração;
+	// as respostas podem chegar fora da ordem dos pedidos
+	EnrichStream(grpc.BidiStreamingServer[SellerStreamRequest, SellerStreamResponse]) error
+	// As escritas validam o registro como as stacks HTTP. CreateSeller ignora o
+	// id recebido e devolve o atribuído; UpdateSeller e PatchSeller usam o id
+	// para achar o registro
+	CreateSeller(context.Context, *Seller) (*Seller, error)
+	UpdateSeller(context.Context, *Seller) (*Seller, error)
+	PatchSeller(context.Context, *PatchSellerRequest) (*Seller, error)
+	DeleteSeller(context.Context, *SellerId) (*emptypb.Empty, error)
+	mustEmbedUnimplementedSellerServiceServer()
+}
+
+// UnimplementedSellerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSellerServiceServer struct{}
+
+func (UnimplementedSellerServiceServer) GetAllSellers(context.Context, *emptypb.Empty) (*SellerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllSellers not implemented")
+}
+func (UnimplementedSellerServiceServer) StreamSellers(*emptypb.Empty, grpc.ServerStreamingServer[Seller]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSellers not implemented")
+}
+func (UnimplementedSellerServiceServer) GetSellerByID(context.Context, *SellerId) (*Seller, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSellerByID not implemented")
+}
+func (UnimplementedSellerServiceServer) EnrichStream(grpc.BidiStreamingServer[SellerStreamRequest, SellerStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method EnrichStream not implemented")
+}
+func (UnimplementedSellerServiceServer) CreateSeller(context.Context, *Seller) (*Seller, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeller not implemented")
+}
+func (UnimplementedSellerServiceServer) UpdateSeller(context.Context, *Seller) (*Seller, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeller not implemented")
+}
+func (UnimplementedSellerServiceServer) PatchSeller(context.Context, *PatchSellerRequest) (*Seller, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchSeller not implemented")
+}
+func (UnimplementedSellerServiceServer) DeleteSeller(context.Context, *SellerId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSeller not implemented")
+}
+func (UnimplementedSellerServiceServer) mustEmbedUnimplementedSellerServiceServer() {}
+func (UnimplementedSellerServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeSellerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SellerServiceServer will
+// result in compilation errors.
+type UnsafeSellerServiceServer interface {
+	mustEmbedUnimplementedSellerServiceServer()
+}
+
+func RegisterSellerServiceServer(s grpc.ServiceRegistrar, srv SellerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSellerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SellerService_ServiceDesc, srv)
+}
+
+func _SellerService_GetAllSellers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SellerServiceServer).GetAllSellers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SellerService_GetAllSellers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SellerServiceServer).GetAllSellers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SellerService_StreamSellers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SellerServiceServer).StreamSellers(m, &grpc.GenericServerStream[emptypb.Empty, Seller]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SellerService_StreamSellersServer = grpc.ServerStreamingServer[Seller]
+
+func _SellerService_GetSellerByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellerId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SellerServiceServer).GetSellerByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SellerService_GetSellerByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SellerServiceServer).GetSellerByID(ctx, req.(*SellerId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SellerService_EnrichStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SellerServiceServer).EnrichStream(&grpc.GenericServerStream[SellerStreamRequest, SellerStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SellerService_EnrichStreamServer = grpc.BidiStreamingServer[SellerStreamRequest, SellerStreamResponse]
+
+func _SellerService_CreateSeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Seller)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SellerServiceServer).CreateSeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SellerService_CreateSeller_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SellerServiceServer).CreateSeller(ctx, req.(*Seller))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SellerService_UpdateSeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Seller)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SellerServiceServer).UpdateSeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SellerService_UpdateSeller_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SellerServiceServer).UpdateSeller(ctx, req.(*Seller))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SellerService_PatchSeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchSellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SellerServiceServer).PatchSeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SellerService_PatchSeller_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SellerServiceServer).PatchSeller(ctx, req.(*PatchSellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SellerService_DeleteSeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellerId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SellerServiceServer).DeleteSeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SellerService_DeleteSeller_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SellerServiceServer).DeleteSeller(ctx, req.(*SellerId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SellerService_ServiceDesc is the grpc.ServiceDesc for SellerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SellerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.SellerService",
+	HandlerType: (*SellerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAllSellers",
+			Handler:    _SellerService_GetAllSellers_Handler,
+		},
+		{
+			MethodName: "GetSellerByID",
+			Handler:    _SellerService_GetSellerByID_Handler,
+		},
+		{
+			MethodName: "CreateSeller",
+			Handler:    _SellerService_CreateSeller_Handler,
+		},
+		{
+			MethodName: "UpdateSeller",
+			Handler:    _SellerService_UpdateSeller_Handler,
+		},
+		{
+			MethodName: "PatchSeller",
+			Handler:    _SellerService_PatchSeller_Handler,
+		},
+		{
+			MethodName: "DeleteSeller",
+			Handler:    _SellerService_DeleteSeller_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSellers",
+			Handler:       _SellerService_StreamSellers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "EnrichStream",
+			Handler:       _SellerService_EnrichStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/seller/seller.proto",
+}
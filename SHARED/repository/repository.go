@@ -0,0 +1,314 @@
+// Package repository guarda os registros de um contexto em memória com
+// índices por ID e por slug. As buscas são O(1) para que o custo medido nos
+// benchmarks seja o do protocolo, não o de varrer o catálogo.
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"shared/domain"
+)
+
+var (
+	ErrNotFound      = errors.New("registro não encontrado")
+	ErrDuplicateSlug = errors.New("slug já usado por outro registro")
+)
+
+// Change descreve uma escrita no repositório: o item gravado, ou o removido
+// em Deleted
+type Change[T any] struct {
+	ID      int
+	Item    T
+	Deleted bool
+}
+
+// Repository mantém os itens na ordem original, usada por All, e os índices
+// para as buscas. É seguro para uso concorrente e o que All devolve nunca muda
+// depois de entregue. As escritas alteram só o item e as entradas de índice
+// que tocam: Delete marca a posição como removida e a lista é compactada
+// quando metade dela é de removidos; a lista de All é remontada na primeira
+// leitura depois de uma escrita.
+type Repository[T any] struct {
+	mu      sync.RWMutex
+	items   []T
+	removed map[int]bool
+	// owned diz que items não é mais a lista recebida em New, que pode ter
+	// sido entregue por All e por isso não pode ser alterada
+	owned      bool
+	duplicated bool
+	all        atomic.Pointer[[]T]
+	byID       map[int]int
+	bySlug     map[string]int
+	id         func(T) int
+	slug       func(T) string
+	nextID     int
+	observers  []func(Change[T])
+}
+
+// New indexa items pelo ID; com IDs repetidos vale o primeiro, como na
+// busca linear
+func New[T any](items []T, id func(T) int) *Repository[T] {
+	r := &Repository[T]{items: items, id: id}
+	r.all.Store(&items)
+	r.reindex()
+	return r
+}
+
+// WithSlug acrescenta o índice por slug, sem diferenciar maiúsculas
+func (r *Repository[T]) WithSlug(slug func(T) string) *Repository[T] {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.slug = slug
+	r.reindex()
+	return r
+}
+
+// reindex refaz os índices a partir de items, que não pode ter removidos;
+// chamado com o lock de escrita
+func (r *Repository[T]) reindex() {
+	r.byID = make(map[int]int, len(r.items))
+	if r.slug != nil {
+		r.bySlug = make(map[string]int, len(r.items))
+	}
+	r.duplicated = false
+	for i, item := range r.items {
+		if _, ok := r.byID[r.id(item)]; ok {
+			r.duplicated = true
+		} else {
+			r.byID[r.id(item)] = i
+		}
+		r.nextID = max(r.nextID, r.id(item)+1)
+		if r.slug == nil {
+			continue
+		}
+		if key := NormalizeSlug(r.slug(item)); r.hasSlug(key) {
+			r.duplicated = true
+		} else {
+			r.bySlug[key] = i
+		}
+	}
+	r.nextID = max(r.nextID, 1)
+}
+
+func (r *Repository[T]) hasSlug(key string) bool {
+	_, ok := r.bySlug[key]
+	return ok
+}
+
+// NormalizeSlug é a forma do slug usada como chave do índice
+func NormalizeSlug(slug string) string {
+	return strings.ToLower(slug)
+}
+
+func (r *Repository[T]) All() []T {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if all := r.all.Load(); all != nil {
+		return *all
+	}
+	// com o lock de leitura nenhuma escrita acontece; leitores simultâneos
+	// podem montar a mesma lista, e qualquer uma delas serve
+	all := make([]T, 0, len(r.items)-len(r.removed))
+	for i, item := range r.items {
+		if !r.removed[i] {
+			all = append(all, item)
+		}
+	}
+	r.all.Store(&all)
+	return all
+}
+
+func (r *Repository[T]) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.items) - len(r.removed)
+}
+
+func (r *Repository[T]) ByID(id int) (T, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return lookup(r.items, r.byID, id)
+}
+
+// ByIDs devolve os itens na ordem pedida; IDs inexistentes ficam de fora
+func (r *Repository[T]) ByIDs(ids []int) []T {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := make([]T, 0, len(ids))
+	for _, id := range ids {
+		if item, ok := lookup(r.items, r.byID, id); ok {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// BySlug só encontra itens de um repositório criado com WithSlug
+func (r *Repository[T]) BySlug(slug string) (T, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return lookup(r.items, r.bySlug, NormalizeSlug(slug))
+}
+
+func lookup[K comparable, T any](items []T, index map[K]int, key K) (T, bool) {
+	i, ok := index[key]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return items[i], true
+}
+
+// OnChange registra fn para receber cada escrita, na ordem em que acontecem.
+// fn roda com o repositório bloqueado, não pode chamá-lo e deve ser rápida:
+// as leituras esperam por ela.
+func (r *Repository[T]) OnChange(fn func(Change[T])) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.observers = append(r.observers, fn)
+}
+
+// Create grava o item montado por build com o próximo ID livre; IDs de itens
+// removidos não são reaproveitados
+func (r *Repository[T]) Create(build func(id int) T) (T, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	item := build(r.nextID)
+	if r.id(item) != r.nextID {
+		var zero T
+		return zero, fmt.Errorf("item criado com ID %d, esperado %d", r.id(item), r.nextID)
+	}
+	if r.slug != nil && r.hasSlug(NormalizeSlug(r.slug(item))) {
+		var zero T
+		return zero, ErrDuplicateSlug
+	}
+
+	r.own()
+	r.items = append(r.items, item)
+	r.byID[r.nextID] = len(r.items) - 1
+	if r.slug != nil {
+		r.bySlug[NormalizeSlug(r.slug(item))] = len(r.items) - 1
+	}
+	r.nextID++
+	r.publish(Change[T]{ID: r.id(item), Item: item})
+	return item, nil
+}
+
+// Update troca o item de ID id pelo que fn devolve a partir do atual; um erro
+// de fn cancela a escrita e é devolvido sem alteração
+func (r *Repository[T]) Update(id int, fn func(current T) (T, error)) (T, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var zero T
+	i, ok := r.byID[id]
+	if !ok {
+		return zero, ErrNotFound
+	}
+	item, err := fn(r.items[i])
+	if err != nil {
+		return zero, err
+	}
+	if r.id(item) != id {
+		return zero, fmt.Errorf("o ID %d não pode mudar para %d", id, r.id(item))
+	}
+	if r.slug != nil {
+		if j, ok := r.bySlug[NormalizeSlug(r.slug(item))]; ok && j != i {
+			return zero, ErrDuplicateSlug
+		}
+		delete(r.bySlug, NormalizeSlug(r.slug(r.items[i])))
+		r.bySlug[NormalizeSlug(r.slug(item))] = i
+	}
+
+	r.own()
+	r.items[i] = item
+	r.publish(Change[T]{ID: id, Item: item})
+	return item, nil
+}
+
+// Delete remove o item de ID id e o devolve
+func (r *Repository[T]) Delete(id int) (T, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i, ok := r.byID[id]
+	if !ok {
+		var zero T
+		return zero, ErrNotFound
+	}
+	item := r.items[i]
+
+	if r.removed == nil {
+		r.removed = make(map[int]bool)
+	}
+	r.removed[i] = true
+	delete(r.byID, id)
+	if r.slug != nil {
+		delete(r.bySlug, NormalizeSlug(r.slug(item)))
+	}
+	// com IDs ou slugs repetidos, o próximo item repetido passa a valer e
+	// só a reindexação o encontra
+	if r.duplicated || 2*len(r.removed) > len(r.items) {
+		r.compact()
+	}
+	r.publish(Change[T]{ID: id, Item: item, Deleted: true})
+	return item, nil
+}
+
+// own troca items por uma cópia antes da primeira escrita nela; chamado com o
+// lock de escrita
+func (r *Repository[T]) own() {
+	if !r.owned {
+		r.items = slices.Clone(r.items)
+		r.owned = true
+	}
+}
+
+// compact tira os removidos de items e refaz os índices; chamado com o lock
+// de escrita
+func (r *Repository[T]) compact() {
+	items := make([]T, 0, len(r.items)-len(r.removed))
+	for i, item := range r.items {
+		if !r.removed[i] {
+			items = append(items, item)
+		}
+	}
+	r.items, r.removed, r.owned = items, nil, true
+	r.reindex()
+}
+
+// publish descarta a lista de All e avisa os observadores; chamado com o
+// lock de escrita e os índices já atualizados
+func (r *Repository[T]) publish(change Change[T]) {
+	r.all.Store(nil)
+	for _, fn := range r.observers {
+		fn(change)
+	}
+}
+
+func Brands(items []domain.Brand) *Repository[domain.Brand] {
+	return New(items, func(b domain.Brand) int { return b.ID })
+}
+
+func Sellers(items []domain.Seller) *Repository[domain.Seller] {
+	return New(items, func(s domain.Seller) int { return s.ID })
+}
+
+func Categories(items []domain.Category) *Repository[domain.Category] {
+	return New(items, func(c domain.Category) int { return c.ID })
+}
+
+func Images(items []domain.Image) *Repository[domain.Image] {
+	return New(items, func(img domain.Image) int { return img.ID })
+}
+
+// Products indexa os produtos por ID e por slug
+func Products(items []domain.Product) *Repository[domain.Product] {
+	return New(items, func(p domain.Product) int { return p.ID }).WithSlug(func(p domain.Product) string { return p.Slug })
+}
@@ -0,0 +1,386 @@
+package repository
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"shared/dataset"
+	"shared/domain"
+)
+
+func TestByID(t *testing.T) {
+	brands := dataset.Brands(20)
+	repo := Brands(brands)
+
+	for _, want := range brands {
+		got, ok := repo.ByID(want.ID)
+		if !ok || got != want {
+			t.Errorf("%d: %+v %v, esperado %+v", want.ID, got, ok, want)
+		}
+	}
+	for _, id := range []int{0, -1, 21} {
+		if got, ok := repo.ByID(id); ok {
+			t.Errorf("%d: %+v, esperado não encontrado", id, got)
+		}
+	}
+	if repo.Len() != len(brands) || &repo.All()[0] != &brands[0] {
+		t.Error("All não devolve os itens originais")
+	}
+}
+
+// Com IDs repetidos vale o primeiro, como na busca linear
+func TestByIDDuplicated(t *testing.T) {
+	repo := Sellers([]domain.Seller{{ID: 1, Name: "primeiro"}, {ID: 1, Name: "segundo"}})
+
+	if got, _ := repo.ByID(1); got.Name != "primeiro" {
+		t.Errorf("%q, esperado primeiro", got.Name)
+	}
+}
+
+func TestByIDs(t *testing.T) {
+	repo := Categories(dataset.Categories(10))
+
+	got := repo.ByIDs([]int{5, 0, 2, 11, 5, -3, 1})
+	var ids []int
+	for _, c := range got {
+		ids = append(ids, c.ID)
+	}
+	if len(ids) != 4 || ids[0] != 5 || ids[1] != 2 || ids[2] != 5 || ids[3] != 1 {
+		t.Errorf("%v, esperado [5 2 5 1]", ids)
+	}
+	if got := repo.ByIDs(nil); got == nil || len(got) != 0 {
+		t.Errorf("%v, esperado lista vazia", got)
+	}
+}
+
+func TestBySlug(t *testing.T) {
+	products := dataset.Products(testConfig(50))
+	repo := Products(products)
+
+	for _, want := range products {
+		for _, slug := range []string{want.Slug, strings.ToUpper(want.Slug)} {
+			got, ok := repo.BySlug(slug)
+			if !ok || got.ID != want.ID {
+				t.Errorf("%s: %d %v, esperado %d", slug, got.ID, ok, want.ID)
+			}
+		}
+	}
+	for _, slug := range []string{"", "nome-do-produto-51", "nome-do-produto"} {
+		if _, ok := repo.BySlug(slug); ok {
+			t.Errorf("%q encontrado", slug)
+		}
+	}
+	if _, ok := Images(dataset.Images(5)).BySlug("image1"); ok {
+		t.Error("slug encontrado em repositório sem WithSlug")
+	}
+}
+
+func TestCreate(t *testing.T) {
+	repo := Brands(dataset.Brands(3))
+	var changes []Change[domain.Brand]
+	repo.OnChange(func(c Change[domain.Brand]) { changes = append(changes, c) })
+
+	created, err := repo.Create(func(id int) domain.Brand { return domain.Brand{ID: id, Name: "Nova"} })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID != 4 || repo.Len() != 4 {
+		t.Errorf("ID %d com %d itens, esperado 4 e 4", created.ID, repo.Len())
+	}
+	if got, ok := repo.ByID(4); !ok || got.Name != "Nova" {
+		t.Errorf("ByID(4) = %+v %v", got, ok)
+	}
+	if len(changes) != 1 || changes[0].ID != 4 || changes[0].Deleted {
+		t.Errorf("mudanças %+v", changes)
+	}
+
+	// IDs removidos não voltam
+	if _, err := repo.Delete(4); err != nil {
+		t.Fatal(err)
+	}
+	created, _ = repo.Create(func(id int) domain.Brand { return domain.Brand{ID: id} })
+	if created.ID != 5 {
+		t.Errorf("ID %d, esperado 5", created.ID)
+	}
+
+	if _, err := repo.Create(func(id int) domain.Brand { return domain.Brand{ID: 1} }); err == nil {
+		t.Error("Create aceitou um ID diferente do atribuído")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	original := dataset.Brands(3)
+	repo := Brands(original)
+	before := repo.All()
+
+	updated, err := repo.Update(2, func(b domain.Brand) (domain.Brand, error) {
+		b.Name = "Alterada"
+		return b, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := repo.ByID(2); got != updated || got.Name != "Alterada" || got.Country != original[1].Country {
+		t.Errorf("ByID(2) = %+v", got)
+	}
+	// a lista entregue antes da escrita não muda
+	if before[1].Name != original[1].Name {
+		t.Errorf("lista publicada alterada: %+v", before[1])
+	}
+
+	if _, err := repo.Update(9, func(b domain.Brand) (domain.Brand, error) { return b, nil }); !errors.Is(err, ErrNotFound) {
+		t.Errorf("%v, esperado ErrNotFound", err)
+	}
+	fail := errors.New("inválido")
+	if _, err := repo.Update(1, func(b domain.Brand) (domain.Brand, error) { return b, fail }); err != fail {
+		t.Errorf("%v, esperado o erro de fn", err)
+	}
+	if _, err := repo.Update(1, func(b domain.Brand) (domain.Brand, error) { b.ID = 3; return b, nil }); err == nil {
+		t.Error("Update aceitou mudar o ID")
+	}
+	if got, _ := repo.ByID(1); got != original[0] {
+		t.Errorf("escrita cancelada alterou o item: %+v", got)
+	}
+}
+
+func TestUpdateSlug(t *testing.T) {
+	repo := Products(dataset.Products(testConfig(3)))
+
+	if _, err := repo.Update(1, func(p domain.Product) (domain.Product, error) {
+		p.Slug = "Nome-Do-Produto-2"
+		return p, nil
+	}); !errors.Is(err, ErrDuplicateSlug) {
+		t.Errorf("%v, esperado ErrDuplicateSlug", err)
+	}
+
+	if _, err := repo.Update(1, func(p domain.Product) (domain.Product, error) {
+		p.Slug = "produto-renomeado"
+		return p, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := repo.BySlug("nome-do-produto-1"); ok {
+		t.Error("slug antigo ainda encontrado")
+	}
+	if p, ok := repo.BySlug("PRODUTO-RENOMEADO"); !ok || p.ID != 1 {
+		t.Errorf("BySlug = %d %v, esperado 1", p.ID, ok)
+	}
+
+	if _, err := repo.Create(func(id int) domain.Product { return domain.Product{ID: id, Slug: "produto-renomeado"} }); !errors.Is(err, ErrDuplicateSlug) {
+		t.Errorf("%v, esperado ErrDuplicateSlug", err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	repo := Products(dataset.Products(testConfig(5)))
+	var changes []Change[domain.Product]
+	repo.OnChange(func(c Change[domain.Product]) { changes = append(changes, c) })
+
+	deleted, err := repo.Delete(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.ID != 2 || repo.Len() != 4 {
+		t.Errorf("removido %d com %d itens", deleted.ID, repo.Len())
+	}
+	if _, ok := repo.ByID(2); ok {
+		t.Error("ByID(2) depois da remoção")
+	}
+	if _, ok := repo.BySlug(deleted.Slug); ok {
+		t.Error("BySlug depois da remoção")
+	}
+	// os itens depois do removido continuam indexados
+	for _, id := range []int{1, 3, 4, 5} {
+		if p, ok := repo.ByID(id); !ok || p.ID != id {
+			t.Errorf("ByID(%d) = %d %v", id, p.ID, ok)
+		}
+		if p, ok := repo.BySlug("nome-do-produto-" + strconv.Itoa(id)); !ok || p.ID != id {
+			t.Errorf("BySlug(%d) = %d %v", id, p.ID, ok)
+		}
+	}
+	if len(changes) != 1 || !changes[0].Deleted || changes[0].ID != 2 {
+		t.Errorf("mudanças %+v", changes)
+	}
+
+	if _, err := repo.Delete(2); !errors.Is(err, ErrNotFound) {
+		t.Errorf("%v, esperado ErrNotFound", err)
+	}
+}
+
+// All continua na ordem original enquanto as remoções se acumulam e depois
+// que a lista é compactada
+func TestDeleteCompacts(t *testing.T) {
+	repo := Products(dataset.Products(testConfig(10)))
+	before := repo.All()
+
+	for _, id := range []int{2, 4, 6, 8, 10, 1} {
+		if _, err := repo.Delete(id); err != nil {
+			t.Fatal(err)
+		}
+		if len(repo.removed) > repo.Len() {
+			t.Fatalf("%d removidos para %d itens sem compactar", len(repo.removed), repo.Len())
+		}
+	}
+	created, _ := repo.Create(func(id int) domain.Product { return domain.Product{ID: id, Slug: "novo"} })
+
+	var ids []int
+	for _, p := range repo.All() {
+		ids = append(ids, p.ID)
+	}
+	if !slices.Equal(ids, []int{3, 5, 7, 9, created.ID}) || created.ID != 11 {
+		t.Errorf("%v, esperado [3 5 7 9 11]", ids)
+	}
+	for _, id := range ids {
+		if p, ok := repo.ByID(id); !ok || p.ID != id {
+			t.Errorf("ByID(%d) = %d %v", id, p.ID, ok)
+		}
+	}
+	if p, ok := repo.BySlug("NOVO"); !ok || p.ID != created.ID {
+		t.Errorf("BySlug(NOVO) = %d %v", p.ID, ok)
+	}
+	if len(before) != 10 || before[1].ID != 2 {
+		t.Error("lista publicada alterada pelas remoções")
+	}
+}
+
+// Removido o primeiro de IDs repetidos, o seguinte passa a valer, como na
+// busca linear
+func TestDeleteDuplicated(t *testing.T) {
+	repo := Sellers([]domain.Seller{{ID: 1, Name: "primeiro"}, {ID: 2}, {ID: 1, Name: "segundo"}})
+
+	if _, err := repo.Delete(1); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := repo.ByID(1); !ok || got.Name != "segundo" {
+		t.Errorf("%+v %v, esperado segundo", got, ok)
+	}
+}
+
+// Leituras e escritas simultâneas; rode com -race
+func TestConcurrent(t *testing.T) {
+	repo := Sellers(dataset.Sellers(100))
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				created, err := repo.Create(func(id int) domain.Seller { return domain.Seller{ID: id, Name: "Novo"} })
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				repo.Update(created.ID, func(s domain.Seller) (domain.Seller, error) {
+					s.Name = "Alterado"
+					return s, nil
+				})
+				repo.Delete(created.ID)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				for _, s := range repo.All() {
+					_ = s.Name
+				}
+				repo.ByIDs([]int{1, 50, 100})
+			}
+		}()
+	}
+	wg.Wait()
+
+	if repo.Len() != 100 {
+		t.Errorf("%d itens, esperado 100", repo.Len())
+	}
+}
+
+func testConfig(size int) dataset.Config {
+	cfg := dataset.DefaultConfig()
+	cfg.Size = size
+	return cfg
+}
+
+// Os benchmarks comparam a busca indexada com a varredura que os contextos
+// faziam antes: go test ./repository -bench . -benchtime 200x
+var benchmarkSizes = []int{100, 10_000, 1_000_000}
+
+func BenchmarkByID(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		sellers := dataset.Sellers(size)
+		repo := Sellers(sellers)
+		// o último ID é o pior caso da varredura
+		id := size
+
+		b.Run("linear/"+strconv.Itoa(size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, s := range sellers {
+					if s.ID == id {
+						break
+					}
+				}
+			}
+		})
+		b.Run("indexed/"+strconv.Itoa(size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				repo.ByID(id)
+			}
+		})
+	}
+}
+
+func BenchmarkBySlug(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		products := dataset.Products(testConfig(size))
+		repo := Products(products)
+		slug := strings.ToUpper(products[size-1].Slug)
+
+		b.Run("linear/"+strconv.Itoa(size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				lower := strings.ToLower(slug)
+				for _, p := range products {
+					if strings.ToLower(p.Slug) == lower {
+						break
+					}
+				}
+			}
+		})
+		b.Run("indexed/"+strconv.Itoa(size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				repo.BySlug(slug)
+			}
+		})
+	}
+}
+
+// As escritas só tocam o item e as entradas de índice que mudam, então o
+// custo não cresce com o catálogo
+func BenchmarkWrite(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		repo := Products(dataset.Products(testConfig(size)))
+		id := size / 2
+
+		b.Run("create-delete/"+strconv.Itoa(size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				created, err := repo.Create(func(id int) domain.Product {
+					return domain.Product{ID: id, Slug: "novo-" + strconv.Itoa(id)}
+				})
+				if err != nil {
+					b.Fatal(err)
+				}
+				repo.Delete(created.ID)
+			}
+		})
+		b.Run("update/"+strconv.Itoa(size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				repo.Update(id, func(p domain.Product) (domain.Product, error) {
+					p.Name = "Alterado"
+					return p, nil
+				})
+			}
+		})
+	}
+}